@@ -0,0 +1,284 @@
+package bybit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DefaultAccountConcurrency : how many accounts a fan-out queries at the same time
+const DefaultAccountConcurrency = 8
+
+// Account : a master or sub-account with its own credentials, registered in an AccountRegistry
+type Account struct {
+	Label string
+	// UID is optional, accounts can be looked up by it when set
+	UID string
+
+	client   *Client
+	wsClient *WebSocketClient
+}
+
+// V5 : REST services signed with the account's key
+func (a *Account) V5() V5ServiceI {
+	return a.client.V5()
+}
+
+// Websocket : WebSocket services signed with the account's key
+func (a *Account) Websocket() *V5WebsocketService {
+	return a.wsClient.V5()
+}
+
+// Client : the REST client of the account
+func (a *Account) Client() *Client {
+	return a.client
+}
+
+// WebsocketClient : the WebSocket client of the account
+func (a *Account) WebsocketClient() *WebSocketClient {
+	return a.wsClient
+}
+
+// AccountRegistry : maps labels and UIDs to accounts
+// Shared options such as the environment, retry policy or metrics apply to every account,
+// each option only configures the clients that support it.
+// A base url or failover only configures the client of its scheme, http(s) for REST and ws(s) for WebSocket.
+// Bybit limits each UID separately, so a shared WithRateLimiterOption gives each account a limiter of its own.
+type AccountRegistry struct {
+	options     []Option
+	concurrency int
+
+	mu       sync.RWMutex
+	accounts map[string]*Account
+}
+
+// NewAccountRegistry :
+func NewAccountRegistry(opts ...Option) *AccountRegistry {
+	return &AccountRegistry{
+		options:     opts,
+		concurrency: DefaultAccountConcurrency,
+		accounts:    map[string]*Account{},
+	}
+}
+
+// WithConcurrency : limits how many accounts a fan-out queries at the same time
+func (r *AccountRegistry) WithConcurrency(concurrency int) *AccountRegistry {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	r.concurrency = concurrency
+
+	return r
+}
+
+// Register : adds an account, opts usually carry its credentials, e.g. WithAuthOption
+// A rate limiter passed here is used as is, share it only between accounts of the same UID.
+func (r *AccountRegistry) Register(label string, uid string, opts ...Option) (*Account, error) {
+	if label == "" {
+		return nil, errors.New("register account: empty label")
+	}
+	shared := make([]Option, len(r.options))
+	for i, opt := range r.options {
+		if opt.rateLimiter != nil {
+			opt = WithRateLimiterOption(opt.rateLimiter.clone())
+		}
+		shared[i] = opt
+	}
+	opts = append(shared, opts...)
+
+	client, err := NewV5Client(supportedOptions(opts, func(o Option) bool {
+		return o.client != nil && o.scheme != "ws" && o.scheme != "wss"
+	})...)
+	if err != nil {
+		return nil, fmt.Errorf("register account %s: %w", label, err)
+	}
+	wsClient, err := NewV5WebsocketClient(supportedOptions(opts, func(o Option) bool {
+		return o.websocket != nil && o.scheme != "http" && o.scheme != "https"
+	})...)
+	if err != nil {
+		return nil, fmt.Errorf("register account %s: %w", label, err)
+	}
+	account := &Account{
+		Label:    label,
+		UID:      uid,
+		client:   client,
+		wsClient: wsClient,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, a := range r.accounts {
+		if a.Label == label {
+			return nil, fmt.Errorf("register account %s: label already registered", label)
+		}
+		if uid != "" && a.UID == uid {
+			return nil, fmt.Errorf("register account %s: uid %s already registered as %s", label, uid, a.Label)
+		}
+	}
+	r.accounts[label] = account
+	return account, nil
+}
+
+// RegisterSubAPIKey : adds a sub-account with a key returned by V5UserService.CreateSubUIDAPIKey
+func (r *AccountRegistry) RegisterSubAPIKey(label string, uid string, key V5CreateSubUIDAPIKeyResult) (*Account, error) {
+	return r.Register(label, uid, WithAuthOption(key.APIKey, key.Secret))
+}
+
+func supportedOptions(opts []Option, supported func(Option) bool) []Option {
+	var result []Option
+	for _, opt := range opts {
+		if supported(opt) {
+			result = append(result, opt)
+		}
+	}
+	return result
+}
+
+// Remove : removes the account with the label or UID, reporting whether it existed
+func (r *AccountRegistry) Remove(labelOrUID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	account := r.lookup(labelOrUID)
+	if account == nil {
+		return false
+	}
+	delete(r.accounts, account.Label)
+	return true
+}
+
+// Account : looks up an account by label, then by UID
+func (r *AccountRegistry) Account(labelOrUID string) (*Account, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	account := r.lookup(labelOrUID)
+	if account == nil {
+		return nil, fmt.Errorf("account %s not registered", labelOrUID)
+	}
+	return account, nil
+}
+
+func (r *AccountRegistry) lookup(labelOrUID string) *Account {
+	if account, ok := r.accounts[labelOrUID]; ok {
+		return account
+	}
+	for _, account := range r.accounts {
+		if account.UID != "" && account.UID == labelOrUID {
+			return account
+		}
+	}
+	return nil
+}
+
+// V5 : REST services of the account with the label or UID
+func (r *AccountRegistry) V5(labelOrUID string) (V5ServiceI, error) {
+	account, err := r.Account(labelOrUID)
+	if err != nil {
+		return nil, err
+	}
+	return account.V5(), nil
+}
+
+// Websocket : WebSocket services of the account with the label or UID
+func (r *AccountRegistry) Websocket(labelOrUID string) (*V5WebsocketService, error) {
+	account, err := r.Account(labelOrUID)
+	if err != nil {
+		return nil, err
+	}
+	return account.Websocket(), nil
+}
+
+// Accounts : every account, sorted by label
+func (r *AccountRegistry) Accounts() []*Account {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*Account, 0, len(r.accounts))
+	for _, account := range r.accounts {
+		result = append(result, account)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Label < result[j].Label
+	})
+	return result
+}
+
+// AccountResult : the outcome of a fan-out for one account
+type AccountResult[T any] struct {
+	Account *Account
+	Value   T
+	Err     error
+}
+
+// AccountResults : fan-out outcomes, sorted by label
+type AccountResults[T any] []AccountResult[T]
+
+// Err : the per-account errors joined, nil when every account succeeded
+func (rs AccountResults[T]) Err() error {
+	var errs []error
+	for _, r := range rs {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.Account.Label, r.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Values : the values of the accounts which succeeded, by label
+func (rs AccountResults[T]) Values() map[string]T {
+	result := make(map[string]T, len(rs))
+	for _, r := range rs {
+		if r.Err == nil {
+			result[r.Account.Label] = r.Value
+		}
+	}
+	return result
+}
+
+// FanOut : runs f for every account of the registry concurrently
+// A failing account does not stop the others, its error is kept in its result.
+func FanOut[T any](ctx context.Context, r *AccountRegistry, f func(context.Context, *Account) (T, error)) AccountResults[T] {
+	accounts := r.Accounts()
+	results := make(AccountResults[T], len(accounts))
+	sem := make(chan struct{}, r.concurrency)
+
+	var wg sync.WaitGroup
+	for i, account := range accounts {
+		results[i].Account = account
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, account *Account) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i].Value, results[i].Err = f(ctx, account)
+		}(i, account)
+	}
+	wg.Wait()
+	return results
+}
+
+// GetWalletBalance : V5AccountService.GetWalletBalance for every account
+func (r *AccountRegistry) GetWalletBalance(ctx context.Context, at AccountTypeV5, coins []Coin) AccountResults[*V5GetWalletBalanceResponse] {
+	return FanOut(ctx, r, func(ctx context.Context, a *Account) (*V5GetWalletBalanceResponse, error) {
+		return a.V5().Account().GetWalletBalanceWithContext(ctx, at, coins)
+	})
+}
+
+// GetPositionInfo : V5PositionService.GetPositionInfo for every account
+func (r *AccountRegistry) GetPositionInfo(ctx context.Context, param V5GetPositionInfoParam) AccountResults[*V5GetPositionInfoResponse] {
+	return FanOut(ctx, r, func(ctx context.Context, a *Account) (*V5GetPositionInfoResponse, error) {
+		return a.V5().Position().GetPositionInfoWithContext(ctx, param)
+	})
+}
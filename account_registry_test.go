@@ -0,0 +1,169 @@
+package bybit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountRegistry(t *testing.T) {
+	server, teardown := testhelper.NewServer(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v5/account/wallet-balance", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Header.Get("X-BAPI-API-KEY") {
+			case "master-key":
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+					"retCode": 0,
+					"result":  map[string]interface{}{"list": []map[string]interface{}{{"totalEquity": "100"}}},
+				})(w, r)
+			case "sub-key":
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+					"retCode": 0,
+					"result":  map[string]interface{}{"list": []map[string]interface{}{{"totalEquity": "5"}}},
+				})(w, r)
+			default:
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 10005, "retMsg": "Permission denied."})(w, r)
+			}
+		})
+	})
+	defer teardown()
+
+	registry := NewAccountRegistry(
+		WithEnvironmentOption(Environment{
+			Name:             "local",
+			RESTBaseURL:      server.URL,
+			WebsocketBaseURL: "ws" + strings.TrimPrefix(server.URL, "http"),
+		}),
+		WithRetryPolicyOption(DefaultRetryPolicy()),
+	).WithConcurrency(2)
+
+	_, err := registry.Register("master", "1", WithAuthOption("master-key", "secret"))
+	require.NoError(t, err)
+	_, err = registry.RegisterSubAPIKey("sub", "2", V5CreateSubUIDAPIKeyResult{APIKey: "sub-key", Secret: "secret"})
+	require.NoError(t, err)
+	_, err = registry.Register("revoked", "3", WithAuthOption("revoked-key", "secret"))
+	require.NoError(t, err)
+
+	t.Run("lookup", func(t *testing.T) {
+		account, err := registry.Account("2")
+		require.NoError(t, err)
+		assert.Equal(t, "sub", account.Label)
+		assert.Equal(t, server.URL, account.Client().baseURL)
+		assert.True(t, strings.HasPrefix(account.WebsocketClient().baseURL, "ws://"))
+
+		_, err = registry.V5("unknown")
+		assert.ErrorContains(t, err, "account unknown not registered")
+	})
+
+	t.Run("duplicates", func(t *testing.T) {
+		_, err := registry.Register("master", "", WithAuthOption("key", "secret"))
+		assert.ErrorContains(t, err, "label already registered")
+		_, err = registry.Register("other", "1", WithAuthOption("key", "secret"))
+		assert.ErrorContains(t, err, "uid 1 already registered as master")
+		_, err = registry.Register("invalid", "", WithAuthOption("", "secret"))
+		assert.ErrorContains(t, err, "register account invalid: auth: empty api key")
+	})
+
+	t.Run("fan out", func(t *testing.T) {
+		results := registry.GetWalletBalance(context.Background(), AccountTypeV5UNIFIED, nil)
+		require.Len(t, results, 3)
+		assert.Equal(t, "master", results[0].Account.Label)
+		assert.Equal(t, "revoked", results[1].Account.Label)
+		assert.Equal(t, "sub", results[2].Account.Label)
+
+		values := results.Values()
+		require.Len(t, values, 2)
+		assert.Equal(t, "100", values["master"].Result.List[0].TotalEquity)
+		assert.Equal(t, "5", values["sub"].Result.List[0].TotalEquity)
+
+		err := results.Err()
+		assert.ErrorContains(t, err, "revoked: ")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		assert.True(t, registry.Remove("3"))
+		assert.False(t, registry.Remove("3"))
+		assert.Len(t, registry.Accounts(), 2)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		results := FanOut(ctx, registry, func(context.Context, *Account) (int, error) {
+			return 1, nil
+		})
+		for _, r := range results {
+			if r.Err != nil {
+				assert.ErrorIs(t, r.Err, context.Canceled)
+			}
+		}
+	})
+}
+
+func TestAccountRegistry_RateLimiterPerAccount(t *testing.T) {
+	server, teardown := testhelper.NewServer(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v5/account/wallet-balance", func(w http.ResponseWriter, r *http.Request) {
+			header := http.Header{}
+			if r.Header.Get("X-BAPI-API-KEY") == "busy-key" {
+				header.Set("X-Bapi-Limit", "10")
+				header.Set("X-Bapi-Limit-Status", "0")
+				header.Set("X-Bapi-Limit-Reset-Timestamp", strconv.FormatInt(time.Now().Add(time.Minute).UnixMilli(), 10))
+			}
+			respondJSON(t, http.StatusOK, header, map[string]interface{}{"retCode": 0})(w, r)
+		})
+	})
+	defer teardown()
+
+	t.Run("independent budgets", func(t *testing.T) {
+		registry := NewAccountRegistry(WithBaseURLOption(server.URL))
+		busy, err := registry.Register("busy", "1", WithAuthOption("busy-key", "secret"), WithRateLimiterOption(NewRateLimiter().WithFailFast(true)))
+		require.NoError(t, err)
+		idle, err := registry.Register("idle", "2", WithAuthOption("idle-key", "secret"), WithRateLimiterOption(NewRateLimiter().WithFailFast(true)))
+		require.NoError(t, err)
+		assert.Equal(t, server.URL, busy.Client().baseURL)
+		assert.Equal(t, WebsocketBaseURL, busy.WebsocketClient().baseURL)
+
+		_, err = busy.V5().Account().GetWalletBalance(AccountTypeV5UNIFIED, nil)
+		require.NoError(t, err)
+		_, err = busy.V5().Account().GetWalletBalance(AccountTypeV5UNIFIED, nil)
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+
+		_, err = idle.V5().Account().GetWalletBalance(AccountTypeV5UNIFIED, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, idle.Client().rateLimiter.Budget("account").Limit)
+	})
+	t.Run("shared limiter is copied per account", func(t *testing.T) {
+		shared := NewRateLimiter().WithFailFast(true)
+		registry := NewAccountRegistry(WithRateLimiterOption(shared))
+		busy, err := registry.Register("busy", "1", WithAuthOption("busy-key", "secret"), WithBaseURLOption(server.URL))
+		require.NoError(t, err)
+		idle, err := registry.Register("idle", "2", WithAuthOption("idle-key", "secret"), WithBaseURLOption(server.URL))
+		require.NoError(t, err)
+		assert.NotSame(t, shared, busy.Client().rateLimiter)
+		assert.NotSame(t, busy.Client().rateLimiter, idle.Client().rateLimiter)
+
+		_, err = busy.V5().Account().GetWalletBalance(AccountTypeV5UNIFIED, nil)
+		require.NoError(t, err)
+		_, err = busy.V5().Account().GetWalletBalance(AccountTypeV5UNIFIED, nil)
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+		_, err = idle.V5().Account().GetWalletBalance(AccountTypeV5UNIFIED, nil)
+		assert.NoError(t, err)
+	})
+	t.Run("failover per scheme", func(t *testing.T) {
+		registry := NewAccountRegistry(
+			WithFailoverOption(NewFailover(server.URL, MainNetBaseURL)),
+			WithFailoverOption(NewFailover(WebsocketBaseURL, WebsocketBaseURL2)),
+		)
+		account, err := registry.Register("master", "1", WithAuthOption("idle-key", "secret"))
+		require.NoError(t, err)
+		assert.Equal(t, server.URL, account.Client().currentBaseURL())
+		assert.NotNil(t, account.WebsocketClient().failover)
+	})
+}
@@ -0,0 +1,50 @@
+package bybit
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithTransport_Cassette(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/position/list", &calls,
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+				"retCode": 0,
+				"result": map[string]interface{}{
+					"category": "linear",
+					"list":     []map[string]interface{}{{"symbol": "BTCUSDT", "size": "0.01"}},
+				},
+			}),
+		),
+	)
+	defer teardown()
+
+	param := V5GetPositionInfoParam{Category: CategoryV5Linear, Symbol: testhelper.Ptr(SymbolV5BTCUSDT)}
+	path := filepath.Join(t.TempDir(), "position.json")
+
+	recorder := testhelper.NewRecorder(nil)
+	client := NewTestClient().
+		WithBaseURL(server.URL).
+		WithAuth("key", "secret").
+		WithTransport(recorder)
+	_, err := client.V5().Position().GetPositionInfo(param)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save(path))
+	teardown()
+
+	replayer, err := testhelper.LoadReplayer(path)
+	require.NoError(t, err)
+	client.WithTransport(replayer)
+
+	resp, err := client.V5().Position().GetPositionInfo(param)
+	require.NoError(t, err)
+	require.Len(t, resp.Result.List, 1)
+	assert.Equal(t, "0.01", resp.Result.List[0].Size)
+	assert.Equal(t, int32(1), calls)
+}
@@ -2,20 +2,15 @@ package bybit
 
 import (
 	"bytes"
-	"crypto"
+	"context"
 	"crypto/hmac"
-	"crypto/rand"
-	"crypto/rsa"
 	"crypto/sha256"
-	"crypto/x509"
-	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"sort"
@@ -24,37 +19,45 @@ import (
 	"time"
 )
 
-const (
-	// MainNetBaseURL :
-	MainNetBaseURL = "https://api.bybit.com"
-	// MainNetBaseURL2 :
-	MainNetBaseURL2 = "https://api.bytick.com"
-)
-
 // Client :
 type Client struct {
 	httpClient *http.Client
 
 	debug  bool
-	logger *log.Logger
+	logger *slog.Logger
 
 	baseURL string
 	key     string
 	secret  string
-
-	// RSA signing support
-	useRSA     bool
-	privateKey *rsa.PrivateKey
+	signer  Signer
 
 	referer string
 
-	checkResponseBody        checkResponseBodyFunc
-	syncTimeDeltaNanoSeconds int64
+	checkResponseBody checkResponseBodyFunc
+	// clock is set by NewClient and shared with the copies made by V5()
+	clock *serverClock
+
+	recvWindow time.Duration
+
+	retryPolicy      *RetryPolicy
+	rateLimiter      *RateLimiter
+	responseMetadata bool
+
+	middleware []Middleware
+	metrics    MetricsSink
+	// failover is shared with the copies made by V5()
+	failover *Failover
 }
 
-func (c *Client) debugf(format string, v ...interface{}) {
-	if c.debug {
-		c.logger.Printf(format, v...)
+// log returns the logger, discarding everything unless debug is enabled
+func (c *Client) log() *slog.Logger {
+	switch {
+	case !c.debug:
+		return discardLogger
+	case c.logger == nil:
+		return defaultSlogLogger()
+	default:
+		return c.logger
 	}
 }
 
@@ -63,10 +66,9 @@ func NewClient() *Client {
 	return &Client{
 		httpClient: &http.Client{},
 
-		logger: newDefaultLogger(),
-
 		baseURL:           MainNetBaseURL,
 		checkResponseBody: checkResponseBody,
+		clock:             &serverClock{},
 	}
 }
 
@@ -77,6 +79,17 @@ func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
 	return c
 }
 
+// WithTransport : sends requests through transport, e.g. testhelper.NewRecorder to record cassettes
+// or testhelper.NewReplayer to serve them back, keeping the timeout of the current http client
+func (c *Client) WithTransport(transport http.RoundTripper) *Client {
+	c.httpClient = &http.Client{
+		Transport: transport,
+		Timeout:   c.httpClient.Timeout,
+	}
+
+	return c
+}
+
 // WithDebug :
 func (c *Client) WithDebug(debug bool) *Client {
 	c.debug = debug
@@ -84,10 +97,21 @@ func (c *Client) WithDebug(debug bool) *Client {
 	return c
 }
 
-// WithLogger :
+// WithLogger : logs through the *log.Logger in the text format of log/slog
+// Prefer WithSlogHandler.
 func (c *Client) WithLogger(logger *log.Logger) *Client {
 	c.debug = true
-	c.logger = logger
+	c.logger = newLogLoggerSlogLogger(logger)
+
+	return c
+}
+
+// WithSlogHandler : enables logging through the handler, which decides the level
+// Requests and responses are logged at debug level, failures at warn level.
+// Keys, signatures and addresses are redacted, see NewRedactingHandler.
+func (c *Client) WithSlogHandler(h slog.Handler) *Client {
+	c.debug = true
+	c.logger = slog.New(NewRedactingHandler(h))
 
 	return c
 }
@@ -96,39 +120,31 @@ func (c *Client) WithLogger(logger *log.Logger) *Client {
 func (c *Client) WithAuth(key string, secret string) *Client {
 	c.key = key
 	c.secret = secret
-	c.useRSA = false
+	c.signer = NewHMACSigner(secret)
 
 	return c
 }
 
 // WithAuthRSA sets up authentication using RSA private key
+// It panics on an invalid key, NewV5Client with WithAuthRSAOption returns an error instead.
 func (c *Client) WithAuthRSA(key string, privateKeyPEM string) *Client {
-	c.key = key
-	c.useRSA = true
-
-	// Parse the private key
-	block, _ := pem.Decode([]byte(privateKeyPEM))
-	if block == nil {
-		panic("failed to parse PEM block containing the private key")
+	privateKey, err := LoadRSAPrivateKeyFromBytes([]byte(privateKeyPEM))
+	if err != nil {
+		panic(err.Error())
 	}
 
-	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	return c.WithSigner(key, NewRSASigner(privateKey))
+}
+
+// WithAuthEd25519 sets up authentication using Ed25519 private key in PKCS8 PEM
+// It panics on an invalid key, NewV5Client with WithAuthEd25519Option returns an error instead.
+func (c *Client) WithAuthEd25519(key string, privateKeyPEM string) *Client {
+	privateKey, err := LoadEd25519PrivateKeyFromBytes([]byte(privateKeyPEM))
 	if err != nil {
-		// Try PKCS8 format if PKCS1 fails
-		parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
-		if err != nil {
-			panic(fmt.Sprintf("failed to parse private key: %v", err))
-		}
-		var ok bool
-		privateKey, ok = parsedKey.(*rsa.PrivateKey)
-		if !ok {
-			panic("not an RSA private key")
-		}
+		panic(err.Error())
 	}
 
-	c.privateKey = privateKey
-
-	return c
+	return c.WithSigner(key, NewEd25519Signer(privateKey))
 }
 
 func (c Client) withCheckResponseBody(f checkResponseBodyFunc) *Client {
@@ -151,60 +167,109 @@ func (c *Client) WithReferer(referer string) *Client {
 }
 
 // Request :
-func (c *Client) Request(req *http.Request, dst interface{}) (err error) {
-	c.debugf("request: %v", req)
-	resp, err := c.httpClient.Do(req)
-	c.debugf("response: %v", resp)
+func (c *Client) Request(req *http.Request, dst interface{}) error {
+	return c.send(req.Context(), &Call{
+		Endpoint: Endpoint{Method: req.Method, Path: req.URL.Path},
+		Request:  req,
+		Response: dst,
+		Attempt:  1,
+	})
+}
+
+// request returns the response alongside the error so that the caller can read its headers,
+// the body is already closed
+func (c *Client) request(req *http.Request, dst interface{}) (resp *http.Response, err error) {
+	ctx := req.Context()
+	logger := c.log()
+	debug := logger.Enabled(ctx, slog.LevelDebug)
+	var attrs []slog.Attr
+	if debug {
+		attrs = requestLogAttrs(req)
+		logger.LogAttrs(ctx, slog.LevelDebug, "request",
+			append(attrs, queryAttr("query", req.URL.Query()), headerAttr("header", req.Header))...)
+	}
+	// failures are logged at warn level, which can be enabled without debug
+	failed := func(attrs []slog.Attr) {
+		if !logger.Enabled(ctx, slog.LevelWarn) {
+			return
+		}
+		if !debug {
+			attrs = append(requestLogAttrs(req), attrs...)
+		}
+		logger.LogAttrs(ctx, slog.LevelWarn, "request failed", attrs...)
+	}
+
+	start := time.Now()
+	resp, err = c.httpClient.Do(req)
 	if err != nil {
-		return err
+		failed(append(attrs, slog.Duration("latency", time.Since(start)), errorAttr("error", err)))
+		return nil, err
 	}
-	c.debugf("response status code: %v", resp.StatusCode)
+	var body []byte
 	defer func() {
 		cerr := resp.Body.Close()
 		if err == nil && cerr != nil {
 			err = cerr
 		}
+
+		if !debug && err == nil {
+			return
+		}
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.Duration("latency", time.Since(start)),
+		)
+		if retCode, ok := retCodeFromBody(body); ok {
+			attrs = append(attrs, slog.Int("retCode", retCode))
+		}
+		if err != nil {
+			failed(append(attrs, errorAttr("error", err)))
+			return
+		}
+		logger.LogAttrs(ctx, slog.LevelDebug, "response", append(attrs, slog.Any("body", jsonLogValue(body)))...)
 	}()
 
 	switch {
 	case 200 <= resp.StatusCode && resp.StatusCode <= 299:
-		body, err := io.ReadAll(resp.Body)
+		body, err = io.ReadAll(resp.Body)
 		if err != nil {
-			return err
+			return resp, err
 		}
 
 		if c.checkResponseBody == nil {
-			return errors.New("checkResponseBody func should be set")
+			return resp, errors.New("checkResponseBody func should be set")
 		}
 		if err := c.checkResponseBody(body); err != nil {
-			return err
+			var rateLimitErr *RateLimitV5Error
+			if errors.As(err, &rateLimitErr) {
+				rateLimitErr.ResetAt = parseRateLimitReset(resp.Header)
+			}
+			return resp, err
 		}
 
 		if err := json.Unmarshal(body, &dst); err != nil {
-			return err
+			return resp, err
 		}
 
-		c.debugf("response body: %v", string(body))
-		return nil
+		return resp, nil
 	case resp.StatusCode == http.StatusBadRequest:
-		return fmt.Errorf("%v: Need to send the request with GET / POST (must be capitalized)", ErrBadRequest)
+		return resp, fmt.Errorf("%v: Need to send the request with GET / POST (must be capitalized)", ErrBadRequest)
 	case resp.StatusCode == http.StatusUnauthorized:
-		return fmt.Errorf("%w: invalid key/secret", ErrInvalidRequest)
+		return resp, fmt.Errorf("%w: invalid key/secret", ErrInvalidRequest)
 	case resp.StatusCode == http.StatusForbidden:
-		return fmt.Errorf("%w: not permitted", ErrForbiddenRequest)
+		return resp, fmt.Errorf("%w: not permitted", ErrForbiddenRequest)
 	case resp.StatusCode == http.StatusNotFound:
-		return fmt.Errorf("%w: wrong path", ErrPathNotFound)
+		return resp, fmt.Errorf("%w: wrong path", ErrPathNotFound)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return resp, fmt.Errorf("%w: status code %d", ErrServerError, resp.StatusCode)
 	default:
-		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		return resp, fmt.Errorf("unexpected status code %d", resp.StatusCode)
 	}
 }
 
 // hasAuth : check has auth key and secret
 func (c *Client) hasAuth() bool {
-	if c.useRSA {
-		return c.key != "" && c.privateKey != nil
-	}
-	return c.key != "" && c.secret != ""
+	return c.key != "" && c.signer != nil
 }
 
 func (c *Client) populateSignature(src url.Values) url.Values {
@@ -222,10 +287,10 @@ func (c *Client) populateSignature(src url.Values) url.Values {
 	return src
 }
 
-func (c *Client) populateSignatureForBody(src []byte) []byte {
+func (c *Client) populateSignatureForBody(src []byte) ([]byte, error) {
 	body := map[string]interface{}{}
 	if err := json.Unmarshal(src, &body); err != nil {
-		panic(err)
+		return nil, fmt.Errorf("json unmarshal: %w", err)
 	}
 
 	body["api_key"] = c.key
@@ -237,84 +302,15 @@ func (c *Client) populateSignatureForBody(src []byte) []byte {
 
 	result, err := json.Marshal(body)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("json marshal: %w", err)
 	}
 
-	return result
-}
-
-func getV5Signature(
-	timestamp int64,
-	key string,
-	queryString string,
-	secret string,
-) string {
-	val := strconv.FormatInt(timestamp, 10) + key
-	val = val + queryString
-	h := hmac.New(sha256.New, []byte(secret))
-	h.Write([]byte(val))
-	return hex.EncodeToString(h.Sum(nil))
+	return result, nil
 }
 
-// getV5SignatureRSA generates RSA signature for V5 API
-func getV5SignatureRSA(
-	timestamp int64,
-	key string,
-	recvWindow string,
-	queryString string,
-	privateKey *rsa.PrivateKey,
-) string {
-	// Build the string to sign: timestamp + api_key + recv_window + queryString
-	val := strconv.FormatInt(timestamp, 10) + key + recvWindow + queryString
-
-	// Calculate SHA256 hash
-	hashed := sha256.Sum256([]byte(val))
-
-	// Sign with RSA private key using PKCS1v15
-	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
-	if err != nil {
-		panic(fmt.Sprintf("failed to sign with RSA: %v", err))
-	}
-
-	// Convert to base64 as required by Bybit for RSA signatures
-	return base64.StdEncoding.EncodeToString(signature)
-}
-
-func getV5SignatureForBody(
-	timestamp int64,
-	key string,
-	body []byte,
-	secret string,
-) string {
-	val := strconv.FormatInt(timestamp, 10) + key
-	val = val + string(body)
-	h := hmac.New(sha256.New, []byte(secret))
-	h.Write([]byte(val))
-	return hex.EncodeToString(h.Sum(nil))
-}
-
-// getV5SignatureForBodyRSA generates RSA signature for V5 API POST requests
-func getV5SignatureForBodyRSA(
-	timestamp int64,
-	key string,
-	recvWindow string,
-	body []byte,
-	privateKey *rsa.PrivateKey,
-) string {
-	// Build the string to sign: timestamp + api_key + recv_window + jsonBodyString
-	val := strconv.FormatInt(timestamp, 10) + key + recvWindow + string(body)
-
-	// Calculate SHA256 hash
-	hashed := sha256.Sum256([]byte(val))
-
-	// Sign with RSA private key using PKCS1v15
-	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
-	if err != nil {
-		panic(fmt.Sprintf("failed to sign with RSA: %v", err))
-	}
-
-	// Convert to base64 as required by Bybit for RSA signatures
-	return base64.StdEncoding.EncodeToString(signature)
+// signV5 signs timestamp + api_key + recv_window + payload, payload being the query string or the json body
+func (c *Client) signV5(timestamp int64, recvWindow string, payload string) (string, SignType, error) {
+	return c.signer.Sign([]byte(strconv.FormatInt(timestamp, 10) + c.key + recvWindow + payload))
 }
 
 func getSignature(src url.Values, key string) string {
@@ -331,10 +327,7 @@ func getSignature(src url.Values, key string) string {
 	}
 	_val = _val[0 : len(_val)-1]
 	h := hmac.New(sha256.New, []byte(key))
-	_, err := io.WriteString(h, _val)
-	if err != nil {
-		panic(err)
-	}
+	h.Write([]byte(_val))
 
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
@@ -353,32 +346,33 @@ func getSignatureForBody(src map[string]interface{}, key string) string {
 	}
 	_val = _val[0 : len(_val)-1]
 	h := hmac.New(sha256.New, []byte(key))
-	_, err := io.WriteString(h, _val)
-	if err != nil {
-		panic(err)
-	}
+	h.Write([]byte(_val))
 
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
 func (c *Client) getPublicly(path string, query url.Values, dst interface{}) error {
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
-	}
-	u.Path = path
-	u.RawQuery = query.Encode()
-
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+	return c.getPubliclyWithContext(context.Background(), path, nil, query, dst)
+}
 
-	return nil
+func (c *Client) getPubliclyWithContext(ctx context.Context, path string, param interface{}, query url.Values, dst interface{}) error {
+	newRequest := func() (*http.Request, error) {
+		u, err := c.requestURL(path, query)
+		if err != nil {
+			return nil, err
+		}
+		return http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	}
+
+	return c.doRequest(ctx, newRequest, requestTarget{
+		endpoint: Endpoint{
+			Method:   http.MethodGet,
+			Path:     path,
+			Category: CategoryV5(query.Get("category")),
+			Param:    param,
+		},
+		idempotent: true,
+	}, dst)
 }
 
 func (c *Client) getPrivately(path string, query url.Values, dst interface{}) error {
@@ -406,45 +400,49 @@ func (c *Client) getPrivately(path string, query url.Values, dst interface{}) er
 	return nil
 }
 
-func (c *Client) getV5Privately(path string, query url.Values, dst interface{}) error {
+func (c *Client) getV5Privately(ctx context.Context, path string, param interface{}, query url.Values, dst interface{}) error {
 	if !c.hasAuth() {
 		return fmt.Errorf("this is private endpoint, please set api key and secret")
 	}
 
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
-	}
-	u.Path = path
-	u.RawQuery = query.Encode()
-
-	timestamp := c.getTimestamp()
-	var sign string
-	if c.useRSA {
-		// For RSA signatures, use recv_window (default 5000ms)
-		recvWindow := "5000"
-		sign = getV5SignatureRSA(timestamp, c.key, recvWindow, query.Encode(), c.privateKey)
-	} else {
-		sign = getV5Signature(timestamp, c.key, query.Encode(), c.secret)
-	}
-
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("X-BAPI-API-KEY", c.key)
-	req.Header.Set("X-BAPI-TIMESTAMP", strconv.FormatInt(timestamp, 10))
-	req.Header.Set("X-BAPI-SIGN", sign)
-	if c.useRSA {
-		req.Header.Set("X-BAPI-SIGN-TYPE", "2")
-		req.Header.Set("X-BAPI-RECV-WINDOW", "5000")
-	}
-
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+	newRequest := func() (*http.Request, error) {
+		u, err := c.requestURL(path, query)
+		if err != nil {
+			return nil, err
+		}
+		timestamp := c.getTimestamp()
+		recvWindow := c.recvWindowFor(ctx)
+		sign, signType, err := c.signV5(timestamp, recvWindow, query.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("sign: %w", err)
+		}
 
-	return nil
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-BAPI-API-KEY", c.key)
+		req.Header.Set("X-BAPI-TIMESTAMP", strconv.FormatInt(timestamp, 10))
+		req.Header.Set("X-BAPI-SIGN", sign)
+		req.Header.Set("X-BAPI-RECV-WINDOW", recvWindow)
+		if header := signType.header(); header != "" {
+			req.Header.Set("X-BAPI-SIGN-TYPE", header)
+		}
+		return req, nil
+	}
+
+	category := CategoryV5(query.Get("category"))
+	return c.doRequest(ctx, newRequest, requestTarget{
+		endpoint: Endpoint{
+			Method:   http.MethodGet,
+			Path:     path,
+			Category: category,
+			Param:    param,
+		},
+		idempotent:     true,
+		signed:         true,
+		rateLimitGroup: RateLimitGroup(path, category),
+	}, dst)
 }
 
 func (c *Client) postJSON(path string, body []byte, dst interface{}) error {
@@ -458,7 +456,10 @@ func (c *Client) postJSON(path string, body []byte, dst interface{}) error {
 	}
 	u.Path = path
 
-	body = c.populateSignatureForBody(body)
+	body, err = c.populateSignatureForBody(body)
+	if err != nil {
+		return err
+	}
 
 	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewBuffer(body))
 	if err != nil {
@@ -473,48 +474,53 @@ func (c *Client) postJSON(path string, body []byte, dst interface{}) error {
 	return nil
 }
 
-func (c *Client) postV5JSON(path string, body []byte, dst interface{}) error {
+func (c *Client) postV5JSON(ctx context.Context, path string, param interface{}, body []byte, dst interface{}) error {
 	if !c.hasAuth() {
 		return fmt.Errorf("this is private endpoint, please set api key and secret")
 	}
 
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
-	}
-	u.Path = path
-
-	timestamp := c.getTimestamp()
-	var sign string
-	if c.useRSA {
-		// For RSA signatures, use recv_window (default 5000ms)
-		recvWindow := "5000"
-		sign = getV5SignatureForBodyRSA(timestamp, c.key, recvWindow, body, c.privateKey)
-	} else {
-		sign = getV5SignatureForBody(timestamp, c.key, body, c.secret)
-	}
-
-	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewBuffer(body))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-BAPI-API-KEY", c.key)
-	req.Header.Set("X-BAPI-TIMESTAMP", strconv.FormatInt(timestamp, 10))
-	req.Header.Set("X-BAPI-SIGN", sign)
-	if c.useRSA {
-		req.Header.Set("X-BAPI-SIGN-TYPE", "2")
-		req.Header.Set("X-BAPI-RECV-WINDOW", "5000")
-	}
-	if c.referer != "" {
-		req.Header.Set("X-Referer", c.referer)
-	}
-
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+	newRequest := func() (*http.Request, error) {
+		u, err := c.requestURL(path, nil)
+		if err != nil {
+			return nil, err
+		}
+		timestamp := c.getTimestamp()
+		recvWindow := c.recvWindowFor(ctx)
+		sign, signType, err := c.signV5(timestamp, recvWindow, string(body))
+		if err != nil {
+			return nil, fmt.Errorf("sign: %w", err)
+		}
 
-	return nil
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-BAPI-API-KEY", c.key)
+		req.Header.Set("X-BAPI-TIMESTAMP", strconv.FormatInt(timestamp, 10))
+		req.Header.Set("X-BAPI-SIGN", sign)
+		req.Header.Set("X-BAPI-RECV-WINDOW", recvWindow)
+		if header := signType.header(); header != "" {
+			req.Header.Set("X-BAPI-SIGN-TYPE", header)
+		}
+		if c.referer != "" {
+			req.Header.Set("X-Referer", c.referer)
+		}
+		return req, nil
+	}
+
+	category := categoryFromBody(body)
+	return c.doRequest(ctx, newRequest, requestTarget{
+		endpoint: Endpoint{
+			Method:   http.MethodPost,
+			Path:     path,
+			Category: category,
+			Param:    param,
+		},
+		idempotent:     hasIdempotencyKey(body),
+		signed:         true,
+		rateLimitGroup: RateLimitGroup(path, category),
+	}, dst)
 }
 
 func (c *Client) postForm(path string, body url.Values, dst interface{}) error {
@@ -524,7 +530,7 @@ func (c *Client) postForm(path string, body url.Values, dst interface{}) error {
 
 	u, err := url.Parse(c.baseURL)
 	if err != nil {
-		return nil
+		return err
 	}
 	u.Path = path
 
@@ -535,9 +541,6 @@ func (c *Client) postForm(path string, body url.Values, dst interface{}) error {
 		return err
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	if err != nil {
-		return err
-	}
 
 	if err := c.Request(req, &dst); err != nil {
 		return err
@@ -572,7 +575,7 @@ func (c *Client) deletePrivately(path string, query url.Values, dst interface{})
 }
 
 func (c *Client) getTimestamp() int64 {
-	return (time.Now().UnixNano() - c.syncTimeDeltaNanoSeconds) / 1000000
+	return time.Now().Add(-c.clock.status().Offset).UnixMilli()
 }
 
 func (c *Client) updateSyncTimeDelta(
@@ -584,19 +587,11 @@ func (c *Client) updateSyncTimeDelta(
 		return fmt.Errorf("parse server time: %w", err)
 	}
 
-	c.syncTimeDeltaNanoSeconds = localTimestampNanoseconds - remoteServerTimeNS
+	c.clock.set(time.Duration(localTimestampNanoseconds-remoteServerTimeNS), 0)
 	return nil
 }
 
+// SyncServerTime :
 func (c *Client) SyncServerTime() error {
-	r, err := c.NewTimeService().GetServerTime()
-	if err != nil {
-		return fmt.Errorf("get server time: %w", err)
-	}
-
-	if r.Result.TimeNano == "" {
-		return errors.New("server time is empty")
-	}
-
-	return c.updateSyncTimeDelta(r.Result.TimeNano, time.Now().UnixNano())
+	return c.SyncServerTimeWithContext(context.Background())
 }
@@ -51,8 +51,8 @@ func TestExampleClient_WithAuthRSA(t *testing.T) {
 	client := NewClient().
 		WithBaseURL(server.URL).
 		WithAuthRSA(TestAPIKey, TestRSAPrivateKey)
-	// useRSA is how the client determines if it should use RSA signing
-	require.True(t, client.useRSA)
+	// the signer is how the client determines if it should use RSA signing
+	require.IsType(t, &RSASigner{}, client.signer)
 	require.True(t, client.hasAuth())
 	// Make some random API call
 	resp, err := client.V5().Account().GetWalletBalance(AccountTypeV5UNIFIED, nil)
@@ -67,17 +67,8 @@ func TestExampleClient_WithAuthRSA(t *testing.T) {
 	// Load up RSA key
 	p, err := LoadRSAPrivateKeyFromBytes([]byte(TestRSAPrivateKey))
 	require.NoError(t, err)
-	// Rebuild sig
 	query := capturedRequest.URL.Query().Encode()
-	expectedSig := getV5SignatureRSA(
-		ts,
-		TestAPIKey,
-		rcv,
-		query,
-		p,
-	)
 	actualSig := capturedRequest.Header.Get("X-BAPI-SIGN")
-	require.Equal(t, expectedSig, actualSig)
 	// verify signature matches private key and payload (timestamp + api_key + recv_window + queryString)
 	payload := strconv.FormatInt(ts, 10) + TestAPIKey + rcv + query
 	hash := sha256.Sum256([]byte(payload))
@@ -109,7 +100,7 @@ func TestLoadRSAPrivateKeyFromFile(t *testing.T) {
 	// Create client with RSA authentication
 	client := NewClient().
 		WithAuthRSA(TestAPIKey, privateKeyPEM)
-	require.True(t, client.useRSA)
+	require.IsType(t, &RSASigner{}, client.signer)
 	require.True(t, client.hasAuth())
 	_ = client
 }
@@ -121,7 +112,7 @@ func TestRSAModeWebSocket(t *testing.T) {
 	// Create WebSocket client with RSA authentication
 	wsClient := NewWebsocketClient().
 		WithAuthRSA(TestAPIKey, privateKeyPEM)
-	require.True(t, wsClient.useRSA)
+	require.IsType(t, &RSASigner{}, wsClient.signer)
 
 	// Use the WebSocket client
 	// The client will automatically use RSA signatures for authentication
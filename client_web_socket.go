@@ -2,18 +2,10 @@ package bybit
 
 import (
 	"context"
-	"crypto"
-	"crypto/hmac"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/sha256"
-	"crypto/x509"
-	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
-	"encoding/pem"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"time"
@@ -21,40 +13,41 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-const (
-	// WebsocketBaseURL :
-	WebsocketBaseURL = "wss://stream.bybit.com"
-	// WebsocketBaseURL2 :
-	WebsocketBaseURL2 = "wss://stream.bytick.com"
-)
-
 // WebSocketClient :
 type WebSocketClient struct {
 	debug  bool
-	logger *log.Logger
+	logger *slog.Logger
 
 	baseURL string
 	key     string
-	secret  string
-	
-	// RSA signing support
-	useRSA     bool
-	privateKey *rsa.PrivateKey
-	
+	signer  Signer
+
+	// publicBaseURL overrides baseURL for the V5 public streams
+	publicBaseURL string
+
 	dialer  *websocket.Dialer
+
+	recvWindow time.Duration
+
+	metrics  MetricsSink
+	failover *Failover
 }
 
-func (c *WebSocketClient) debugf(format string, v ...interface{}) {
-	if c.debug {
-		c.logger.Printf(format, v...)
+// log returns the logger, discarding everything unless debug is enabled
+func (c *WebSocketClient) log() *slog.Logger {
+	switch {
+	case !c.debug:
+		return discardLogger
+	case c.logger == nil:
+		return defaultSlogLogger()
+	default:
+		return c.logger
 	}
 }
 
 // NewWebsocketClient :
 func NewWebsocketClient() *WebSocketClient {
 	return &WebSocketClient{
-		logger: newDefaultLogger(),
-
 		baseURL: WebsocketBaseURL,
 	}
 }
@@ -66,10 +59,20 @@ func (c *WebSocketClient) WithDebug(debug bool) *WebSocketClient {
 	return c
 }
 
-// WithLogger :
+// WithLogger : logs through the *log.Logger in the text format of log/slog
+// Prefer WithSlogHandler.
 func (c *WebSocketClient) WithLogger(logger *log.Logger) *WebSocketClient {
 	c.debug = true
-	c.logger = logger
+	c.logger = newLogLoggerSlogLogger(logger)
+
+	return c
+}
+
+// WithSlogHandler : enables logging through the handler, which decides the level
+// Keys, signatures and addresses are redacted, see NewRedactingHandler.
+func (c *WebSocketClient) WithSlogHandler(h slog.Handler) *WebSocketClient {
+	c.debug = true
+	c.logger = slog.New(NewRedactingHandler(h))
 
 	return c
 }
@@ -77,45 +80,37 @@ func (c *WebSocketClient) WithLogger(logger *log.Logger) *WebSocketClient {
 // WithAuth :
 func (c *WebSocketClient) WithAuth(key string, secret string) *WebSocketClient {
 	c.key = key
-	c.secret = secret
-	c.useRSA = false
+	c.signer = NewHMACSigner(secret)
 
 	return c
 }
 
 // WithAuthRSA sets up authentication using RSA private key
+// It panics on an invalid key, NewV5WebsocketClient with WithAuthRSAOption returns an error instead.
 func (c *WebSocketClient) WithAuthRSA(key string, privateKeyPEM string) *WebSocketClient {
-	c.key = key
-	c.useRSA = true
-	
-	// Parse the private key
-	block, _ := pem.Decode([]byte(privateKeyPEM))
-	if block == nil {
-		panic("failed to parse PEM block containing the private key")
+	privateKey, err := LoadRSAPrivateKeyFromBytes([]byte(privateKeyPEM))
+	if err != nil {
+		panic(err.Error())
 	}
-	
-	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+
+	return c.WithSigner(key, NewRSASigner(privateKey))
+}
+
+// WithAuthEd25519 sets up authentication using Ed25519 private key in PKCS8 PEM
+// It panics on an invalid key, NewV5WebsocketClient with WithAuthEd25519Option returns an error instead.
+func (c *WebSocketClient) WithAuthEd25519(key string, privateKeyPEM string) *WebSocketClient {
+	privateKey, err := LoadEd25519PrivateKeyFromBytes([]byte(privateKeyPEM))
 	if err != nil {
-		// Try PKCS8 format if PKCS1 fails
-		parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
-		if err != nil {
-			panic(fmt.Sprintf("failed to parse private key: %v", err))
-		}
-		var ok bool
-		privateKey, ok = parsedKey.(*rsa.PrivateKey)
-		if !ok {
-			panic("not an RSA private key")
-		}
+		panic(err.Error())
 	}
-	
-	c.privateKey = privateKey
-	
-	return c
+
+	return c.WithSigner(key, NewEd25519Signer(privateKey))
 }
 
 // WithBaseURL :
 func (c *WebSocketClient) WithBaseURL(url string) *WebSocketClient {
 	c.baseURL = url
+	c.publicBaseURL = ""
 
 	return c
 }
@@ -129,7 +124,7 @@ func (c *WebSocketClient) WithDialer(dialer *websocket.Dialer) *WebSocketClient
 
 // hasAuth : check has auth key and secret
 func (c *WebSocketClient) hasAuth() bool {
-	return c.key != "" && c.secret != ""
+	return c.key != "" && c.signer != nil
 }
 
 func (c *WebSocketClient) buildAuthParam() ([]byte, error) {
@@ -139,25 +134,12 @@ func (c *WebSocketClient) buildAuthParam() ([]byte, error) {
 
 	expires := time.Now().Unix()*1000 + 10000
 	req := fmt.Sprintf("GET/realtime%d", expires)
-	
-	var signature string
-	if c.useRSA {
-		// For RSA signatures
-		hashed := sha256.Sum256([]byte(req))
-		sig, err := rsa.SignPKCS1v15(rand.Reader, c.privateKey, crypto.SHA256, hashed[:])
-		if err != nil {
-			return nil, fmt.Errorf("failed to sign with RSA: %w", err)
-		}
-		signature = base64.StdEncoding.EncodeToString(sig)
-	} else {
-		// For HMAC signatures
-		s := hmac.New(sha256.New, []byte(c.secret))
-		if _, err := s.Write([]byte(req)); err != nil {
-			return nil, err
-		}
-		signature = hex.EncodeToString(s.Sum(nil))
+
+	signature, _, err := c.signer.Sign([]byte(req))
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
 	}
-	
+
 	param := struct {
 		Op   string        `json:"op"`
 		Args []interface{} `json:"args"`
@@ -192,7 +174,7 @@ func (c *WebSocketClient) Start(ctx context.Context, executors []WebsocketExecut
 					if IsErrWebsocketClosed(err) {
 						return
 					}
-					c.debugf("websocket executor error: %s", err)
+					c.log().Error("websocket executor error", slog.Any("error", err))
 					return
 				}
 			}
@@ -216,7 +198,7 @@ func (c *WebSocketClient) Start(ctx context.Context, executors []WebsocketExecut
 				}
 			}
 		case <-ctx.Done():
-			c.debugf("caught websocket interrupt signal")
+			c.log().Info("caught websocket interrupt signal")
 
 			for _, executor := range executors {
 				if err := executor.Close(); err != nil {
@@ -231,3 +213,10 @@ func (c *WebSocketClient) Start(ctx context.Context, executors []WebsocketExecut
 		}
 	}
 }
+
+func (c *WebSocketClient) publicURL() string {
+	if c.publicBaseURL != "" {
+		return c.publicBaseURL
+	}
+	return c.baseURL
+}
@@ -0,0 +1,204 @@
+package bybit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config : environment and credentials, loaded with LoadConfigFromEnv or LoadConfigFile
+type Config struct {
+	// Environment is the name of a built-in environment, mainnet when empty
+	Environment string `json:"environment"`
+	Key         string `json:"key"`
+	// Secret of a system-generated api key
+	Secret string `json:"secret,omitempty"`
+	// PrivateKey is the PEM of a self-generated api key, PrivateKeyFile points to it
+	PrivateKey     string `json:"private_key,omitempty"`
+	PrivateKeyFile string `json:"private_key_file,omitempty"`
+	// SignType is RSA or Ed25519 for self-generated api keys, ignored with Secret
+	SignType SignType `json:"sign_type,omitempty"`
+}
+
+// DefaultConfigEnvPrefix : prefix of the variables read by LoadConfigFromEnv
+const DefaultConfigEnvPrefix = "BYBIT"
+
+// LoadConfigFromEnv : reads <prefix>_ENV, <prefix>_KEY, <prefix>_SECRET, <prefix>_PRIVATE_KEY,
+// <prefix>_PRIVATE_KEY_FILE and <prefix>_SIGN_TYPE, e.g. BYBIT_KEY with DefaultConfigEnvPrefix
+func LoadConfigFromEnv(prefix string) (Config, error) {
+	get := func(name string) string {
+		return os.Getenv(prefix + "_" + name)
+	}
+	cfg := Config{
+		Environment:    get("ENV"),
+		Key:            get("KEY"),
+		Secret:         get("SECRET"),
+		PrivateKey:     get("PRIVATE_KEY"),
+		PrivateKeyFile: get("PRIVATE_KEY_FILE"),
+		SignType:       SignType(get("SIGN_TYPE")),
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("%s_*: %w", prefix, err)
+	}
+	return cfg, nil
+}
+
+// LoadConfigFile : reads a JSON config file
+//
+//	{"environment": "demo", "key": "...", "private_key_file": "bybit.pem", "sign_type": "Ed25519"}
+func LoadConfigFile(path string) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("read config: %w", err)
+	}
+	defer f.Close()
+
+	var cfg Config
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&cfg); err != nil {
+		return Config{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate : checks that the environment exists and the credentials are complete
+// A config without Key is valid and only reaches public endpoints.
+func (c Config) Validate() error {
+	if _, err := c.environment(); err != nil {
+		return err
+	}
+	switch {
+	case c.Key == "":
+		if c.Secret != "" || c.PrivateKey != "" || c.PrivateKeyFile != "" {
+			return errors.New("key is required with a secret or private key")
+		}
+	case c.Secret != "":
+		if c.PrivateKey != "" || c.PrivateKeyFile != "" {
+			return errors.New("set either secret or private key")
+		}
+	case c.PrivateKey != "" && c.PrivateKeyFile != "":
+		return errors.New("set either private key or private key file")
+	case c.PrivateKey == "" && c.PrivateKeyFile == "":
+		return errors.New("secret or private key is required with a key")
+	default:
+		if signType := c.signType(); signType != SignTypeRSA && signType != SignTypeEd25519 {
+			return fmt.Errorf("sign type must be %s or %s with a private key, got %q", SignTypeRSA, SignTypeEd25519, c.SignType)
+		}
+	}
+	return nil
+}
+
+// signType matches SignType case-insensitively
+func (c Config) signType() SignType {
+	for _, signType := range []SignType{SignTypeRSA, SignTypeEd25519} {
+		if strings.EqualFold(string(c.SignType), string(signType)) {
+			return signType
+		}
+	}
+	return c.SignType
+}
+
+func (c Config) environment() (Environment, error) {
+	if c.Environment == "" {
+		return EnvironmentMainnet, nil
+	}
+	return EnvironmentByName(c.Environment)
+}
+
+// Options : options for NewV5Client and NewV5WebsocketClient, reading the private key file if any
+func (c Config) Options() ([]Option, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	env, err := c.environment()
+	if err != nil {
+		return nil, err
+	}
+	authOpts, err := c.authOptions()
+	if err != nil {
+		return nil, err
+	}
+	return append([]Option{WithEnvironmentOption(env)}, authOpts...), nil
+}
+
+// authOptions : the credentials of Options, without the environment
+func (c Config) authOptions() ([]Option, error) {
+	switch {
+	case c.Key == "":
+		return nil, nil
+	case c.Secret != "":
+		return []Option{WithAuthOption(c.Key, c.Secret)}, nil
+	}
+	privateKey := c.PrivateKey
+	if c.PrivateKeyFile != "" {
+		data, err := os.ReadFile(c.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read private key: %w", err)
+		}
+		privateKey = string(data)
+	}
+	if c.signType() == SignTypeRSA {
+		return []Option{WithAuthRSAOption(c.Key, privateKey)}, nil
+	}
+	return []Option{WithAuthEd25519Option(c.Key, privateKey)}, nil
+}
+
+// NewV5ClientFromEnv : NewV5Client configured by LoadConfigFromEnv, opts are applied afterwards
+func NewV5ClientFromEnv(prefix string, opts ...Option) (*Client, error) {
+	cfg, err := LoadConfigFromEnv(prefix)
+	if err != nil {
+		return nil, err
+	}
+	cfgOpts, err := cfg.Options()
+	if err != nil {
+		return nil, err
+	}
+	return NewV5Client(append(cfgOpts, opts...)...)
+}
+
+// NewV5WebsocketClientFromEnv : NewV5WebsocketClient configured by LoadConfigFromEnv, opts are applied afterwards
+func NewV5WebsocketClientFromEnv(prefix string, opts ...Option) (*WebSocketClient, error) {
+	cfg, err := LoadConfigFromEnv(prefix)
+	if err != nil {
+		return nil, err
+	}
+	cfgOpts, err := cfg.Options()
+	if err != nil {
+		return nil, err
+	}
+	return NewV5WebsocketClient(append(cfgOpts, opts...)...)
+}
+
+// testAuthOptionsFromEnv : credential options from the BYBIT_TEST_* variables
+func testAuthOptionsFromEnv() []Option {
+	cfg, err := LoadConfigFromEnv("BYBIT_TEST")
+	if err != nil {
+		panic(err.Error())
+	}
+	if cfg.Key == "" {
+		panic("need BYBIT_TEST_KEY as environment variable")
+	}
+	opts, err := cfg.authOptions()
+	if err != nil {
+		panic(err.Error())
+	}
+	return opts
+}
+
+// testEnvironmentOptionFromEnv : the environment of BYBIT_TEST_ENV, testnet when unset
+func testEnvironmentOptionFromEnv() Option {
+	env := EnvironmentTestnet
+	if name := os.Getenv("BYBIT_TEST_ENV"); name != "" {
+		var err error
+		if env, err = EnvironmentByName(name); err != nil {
+			panic(err.Error())
+		}
+	}
+	return WithEnvironmentOption(env)
+}
@@ -0,0 +1,164 @@
+package bybit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironment(t *testing.T) {
+	t.Run("by name", func(t *testing.T) {
+		env, err := EnvironmentByName("Demo")
+		require.NoError(t, err)
+		assert.Equal(t, EnvironmentDemo, env)
+
+		_, err = EnvironmentByName("staging")
+		assert.Error(t, err)
+	})
+	t.Run("demo", func(t *testing.T) {
+		client := NewClient().WithEnvironment(EnvironmentDemo)
+		assert.Equal(t, DemoBaseURL, client.baseURL)
+
+		wsClient := NewWebsocketClient().WithEnvironment(EnvironmentDemo)
+		assert.Equal(t, DemoWebsocketBaseURL, wsClient.baseURL)
+		assert.Equal(t, WebsocketBaseURL, wsClient.publicURL())
+
+		wsClient.WithBaseURL("ws://localhost")
+		assert.Equal(t, "ws://localhost", wsClient.publicURL())
+	})
+	t.Run("testnet", func(t *testing.T) {
+		wsClient := NewWebsocketClient().WithEnvironment(EnvironmentTestnet)
+		assert.Equal(t, TestWebsocketBaseURL, wsClient.publicURL())
+	})
+	t.Run("option", func(t *testing.T) {
+		client, err := NewV5Client(WithEnvironmentOption(EnvironmentBytick))
+		require.NoError(t, err)
+		assert.Equal(t, MainNetBaseURL2, client.baseURL)
+
+		_, err = NewV5Client(WithEnvironmentOption(Environment{Name: "empty"}))
+		assert.ErrorContains(t, err, `environment "empty" has no REST base url`)
+	})
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	t.Run("hmac", func(t *testing.T) {
+		t.Setenv("BYBIT_ENV", "testnet")
+		t.Setenv("BYBIT_KEY", "key")
+		t.Setenv("BYBIT_SECRET", "secret")
+
+		cfg, err := LoadConfigFromEnv(DefaultConfigEnvPrefix)
+		require.NoError(t, err)
+		assert.Equal(t, Config{Environment: "testnet", Key: "key", Secret: "secret"}, cfg)
+
+		client, err := NewV5ClientFromEnv(DefaultConfigEnvPrefix)
+		require.NoError(t, err)
+		assert.Equal(t, TestNetBaseURL, client.baseURL)
+		assert.IsType(t, &HMACSigner{}, client.signer)
+	})
+	t.Run("ed25519 from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ed25519.pem")
+		require.NoError(t, os.WriteFile(path, []byte(TestEd25519PrivateKey), 0600))
+
+		t.Setenv("BYBIT_DEMO_ENV", "demo")
+		t.Setenv("BYBIT_DEMO_KEY", "key")
+		t.Setenv("BYBIT_DEMO_PRIVATE_KEY_FILE", path)
+		t.Setenv("BYBIT_DEMO_SIGN_TYPE", "ed25519")
+
+		wsClient, err := NewV5WebsocketClientFromEnv("BYBIT_DEMO")
+		require.NoError(t, err)
+		assert.Equal(t, DemoWebsocketBaseURL, wsClient.baseURL)
+		assert.IsType(t, &Ed25519Signer{}, wsClient.signer)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("BYBIT_KEY", "key")
+		t.Setenv("BYBIT_SECRET", "")
+		t.Setenv("BYBIT_PRIVATE_KEY", "")
+		t.Setenv("BYBIT_PRIVATE_KEY_FILE", "")
+
+		_, err := LoadConfigFromEnv(DefaultConfigEnvPrefix)
+		assert.ErrorContains(t, err, "BYBIT_*: secret or private key is required with a key")
+	})
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(t *testing.T, content string) string {
+		path := filepath.Join(dir, t.Name()+".json")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	t.Run("rsa", func(t *testing.T) {
+		content, err := json.Marshal(Config{
+			Environment: "mainnet",
+			Key:         "key",
+			PrivateKey:  TestRSAPrivateKey,
+			SignType:    SignTypeRSA,
+		})
+		require.NoError(t, err)
+		path := write(t, string(content))
+
+		cfg, err := LoadConfigFile(path)
+		require.NoError(t, err)
+		opts, err := cfg.Options()
+		require.NoError(t, err)
+
+		client, err := NewV5Client(opts...)
+		require.NoError(t, err)
+		assert.Equal(t, MainNetBaseURL, client.baseURL)
+		assert.IsType(t, &RSASigner{}, client.signer)
+	})
+	t.Run("public only", func(t *testing.T) {
+		cfg, err := LoadConfigFile(write(t, `{"environment": "bytick"}`))
+		require.NoError(t, err)
+		assert.Equal(t, Config{Environment: "bytick"}, cfg)
+	})
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := LoadConfigFile(write(t, `{"api_key": "key"}`))
+		assert.ErrorContains(t, err, `unknown field "api_key"`)
+	})
+	t.Run("missing sign type", func(t *testing.T) {
+		_, err := LoadConfigFile(write(t, `{"key": "key", "private_key_file": "key.pem"}`))
+		assert.ErrorContains(t, err, "sign type must be RSA or Ed25519")
+	})
+	t.Run("unknown environment", func(t *testing.T) {
+		_, err := LoadConfigFile(write(t, `{"environment": "staging"}`))
+		assert.ErrorContains(t, err, `unknown environment "staging"`)
+	})
+}
+
+func TestTestClient_WithAuthFromEnv(t *testing.T) {
+	t.Setenv("BYBIT_TEST_ENV", "demo")
+	t.Setenv("BYBIT_TEST_KEY", "key")
+	t.Setenv("BYBIT_TEST_SECRET", "secret")
+
+	t.Run("keeps the base url", func(t *testing.T) {
+		client := NewTestClient()
+		client.WithBaseURL("http://127.0.0.1:1234")
+		client.WithAuthFromEnv()
+		assert.Equal(t, "http://127.0.0.1:1234", client.baseURL)
+		assert.True(t, client.hasAuth())
+
+		wsClient := NewTestWebsocketClient().WithAuthFromEnv()
+		assert.Equal(t, TestWebsocketBaseURL, wsClient.baseURL)
+		assert.True(t, wsClient.hasAuth())
+	})
+	t.Run("environment", func(t *testing.T) {
+		client := NewTestClient().WithEnvironmentFromEnv().WithAuthFromEnv()
+		assert.Equal(t, DemoBaseURL, client.baseURL)
+
+		wsClient := NewTestWebsocketClient().WithEnvironmentFromEnv()
+		assert.Equal(t, DemoWebsocketBaseURL, wsClient.baseURL)
+		assert.False(t, wsClient.hasAuth())
+
+		t.Setenv("BYBIT_TEST_ENV", "")
+		client = NewTestClient()
+		client.WithBaseURL(DemoBaseURL)
+		assert.Equal(t, TestNetBaseURL, client.WithEnvironmentFromEnv().baseURL)
+	})
+}
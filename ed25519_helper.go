@@ -0,0 +1,38 @@
+package bybit
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+// LoadEd25519PrivateKeyFromFile loads an Ed25519 private key from a PKCS8 PEM file
+func LoadEd25519PrivateKeyFromFile(path string) (ed25519.PrivateKey, error) {
+	keyData, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key file: %w", err)
+	}
+
+	return LoadEd25519PrivateKeyFromBytes(keyData)
+}
+
+// LoadEd25519PrivateKeyFromBytes loads an Ed25519 private key from PKCS8 PEM bytes
+func LoadEd25519PrivateKeyFromBytes(keyData []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(keyData)
+	if block == nil {
+		return nil, fmt.Errorf("failed to parse PEM block containing the private key")
+	}
+
+	parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	privateKey, ok := parsedKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("not an Ed25519 private key")
+	}
+
+	return privateKey, nil
+}
@@ -0,0 +1,122 @@
+package bybit
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MainNetBaseURL :
+	MainNetBaseURL = "https://api.bybit.com"
+	// MainNetBaseURL2 :
+	MainNetBaseURL2 = "https://api.bytick.com"
+	// TestNetBaseURL :
+	TestNetBaseURL = "https://api-testnet.bybit.com"
+	// DemoBaseURL : demo trading
+	DemoBaseURL = "https://api-demo.bybit.com"
+
+	// WebsocketBaseURL :
+	WebsocketBaseURL = "wss://stream.bybit.com"
+	// WebsocketBaseURL2 :
+	WebsocketBaseURL2 = "wss://stream.bytick.com"
+	// TestWebsocketBaseURL :
+	TestWebsocketBaseURL = "wss://stream-testnet.bybit.com"
+	// DemoWebsocketBaseURL : demo trading, private streams only
+	DemoWebsocketBaseURL = "wss://stream-demo.bybit.com"
+)
+
+// Environment : REST and WebSocket URLs of a Bybit deployment
+type Environment struct {
+	Name             string
+	RESTBaseURL      string
+	WebsocketBaseURL string
+	// PublicWebsocketBaseURL serves the public streams when it differs from WebsocketBaseURL,
+	// e.g. demo trading only has private streams and reads market data from mainnet
+	PublicWebsocketBaseURL string
+}
+
+var (
+	// EnvironmentMainnet :
+	EnvironmentMainnet = Environment{
+		Name:             "mainnet",
+		RESTBaseURL:      MainNetBaseURL,
+		WebsocketBaseURL: WebsocketBaseURL,
+	}
+	// EnvironmentBytick : mainnet through the bytick domain
+	EnvironmentBytick = Environment{
+		Name:             "bytick",
+		RESTBaseURL:      MainNetBaseURL2,
+		WebsocketBaseURL: WebsocketBaseURL2,
+	}
+	// EnvironmentTestnet :
+	EnvironmentTestnet = Environment{
+		Name:             "testnet",
+		RESTBaseURL:      TestNetBaseURL,
+		WebsocketBaseURL: TestWebsocketBaseURL,
+	}
+	// EnvironmentDemo : demo trading with mainnet market data
+	EnvironmentDemo = Environment{
+		Name:                   "demo",
+		RESTBaseURL:            DemoBaseURL,
+		WebsocketBaseURL:       DemoWebsocketBaseURL,
+		PublicWebsocketBaseURL: WebsocketBaseURL,
+	}
+)
+
+// Environments : the built-in environments
+func Environments() []Environment {
+	return []Environment{EnvironmentMainnet, EnvironmentBytick, EnvironmentTestnet, EnvironmentDemo}
+}
+
+// EnvironmentByName : looks up a built-in environment, case-insensitively
+func EnvironmentByName(name string) (Environment, error) {
+	for _, env := range Environments() {
+		if strings.EqualFold(env.Name, name) {
+			return env, nil
+		}
+	}
+	return Environment{}, fmt.Errorf("unknown environment %q", name)
+}
+
+func (e Environment) publicWebsocketBaseURL() string {
+	if e.PublicWebsocketBaseURL != "" {
+		return e.PublicWebsocketBaseURL
+	}
+	return e.WebsocketBaseURL
+}
+
+// WithEnvironment :
+func (c *Client) WithEnvironment(env Environment) *Client {
+	c.baseURL = env.RESTBaseURL
+
+	return c
+}
+
+// WithEnvironment :
+func (c *WebSocketClient) WithEnvironment(env Environment) *WebSocketClient {
+	c.baseURL = env.WebsocketBaseURL
+	c.publicBaseURL = env.publicWebsocketBaseURL()
+
+	return c
+}
+
+// WithEnvironmentOption :
+func WithEnvironmentOption(env Environment) Option {
+	return Option{
+		name: "environment",
+		client: func(c *Client) error {
+			if env.RESTBaseURL == "" {
+				return fmt.Errorf("environment %q has no REST base url", env.Name)
+			}
+			c.WithEnvironment(env)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			if env.WebsocketBaseURL == "" {
+				return fmt.Errorf("environment %q has no websocket base url", env.Name)
+			}
+			c.WithEnvironment(env)
+			return nil
+		},
+	}
+}
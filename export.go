@@ -0,0 +1,460 @@
+package bybit
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ColumnType : how a Column is formatted and written to JSON Lines
+type ColumnType int
+
+const (
+	// ColumnText : written as is, a JSON string
+	ColumnText ColumnType = iota
+	// ColumnNumber : a decimal string, a JSON number in JSON Lines and null when empty
+	// Values which are not decimals, e.g. "1e-5", are written as JSON strings unless WithStrictNumbersOption is set.
+	ColumnNumber
+	// ColumnTime : a timestamp in milliseconds, formatted with WithTimeFormatOption
+	ColumnTime
+	// ColumnBool : true or false, a JSON boolean
+	ColumnBool
+)
+
+// Column : a named field of the exported records
+type Column[T any] struct {
+	Name  string
+	Type  ColumnType
+	Value func(T) string
+}
+
+// ExportOption : configures NewCSVWriter and NewJSONLWriter
+type ExportOption func(*exportConfig)
+
+type exportConfig struct {
+	timeLayout   string
+	timeLocation *time.Location
+	normalize    bool
+	strict       bool
+}
+
+// WithTimeFormatOption : formats ColumnTime with the layout, in UTC unless loc is set
+// Without it timestamps stay milliseconds since the epoch.
+func WithTimeFormatOption(layout string, loc *time.Location) ExportOption {
+	return func(c *exportConfig) {
+		c.timeLayout = layout
+		c.timeLocation = loc
+	}
+}
+
+// WithNumberNormalizationOption : rewrites ColumnNumber in canonical form, "0.0100" as "0.01" and ".5" as "0.5"
+func WithNumberNormalizationOption(enabled bool) ExportOption {
+	return func(c *exportConfig) {
+		c.normalize = enabled
+	}
+}
+
+// WithStrictNumbersOption : fails writing a JSON Lines record whose ColumnNumber value is not a decimal
+// instead of writing it as a string, keeping the type of every column fixed
+func WithStrictNumbersOption(enabled bool) ExportOption {
+	return func(c *exportConfig) {
+		c.strict = enabled
+	}
+}
+
+// RecordWriter : streams records to CSV with a header row or to JSON Lines
+// Records are buffered, call Flush when done.
+type RecordWriter[T any] struct {
+	columns []Column[T]
+	config  exportConfig
+
+	csv           *csv.Writer
+	headerWritten bool
+
+	jsonl *bufio.Writer
+}
+
+// NewCSVWriter : the header row holds the column names and is written before the first record
+func NewCSVWriter[T any](w io.Writer, columns []Column[T], opts ...ExportOption) *RecordWriter[T] {
+	return &RecordWriter[T]{
+		columns: columns,
+		config:  newExportConfig(opts),
+		csv:     csv.NewWriter(w),
+	}
+}
+
+// NewJSONLWriter : one JSON object per line, keys in column order
+func NewJSONLWriter[T any](w io.Writer, columns []Column[T], opts ...ExportOption) *RecordWriter[T] {
+	return &RecordWriter[T]{
+		columns: columns,
+		config:  newExportConfig(opts),
+		jsonl:   bufio.NewWriter(w),
+	}
+}
+
+func newExportConfig(opts []ExportOption) exportConfig {
+	config := exportConfig{timeLocation: time.UTC}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.timeLocation == nil {
+		config.timeLocation = time.UTC
+	}
+	return config
+}
+
+// Write : writes one record
+func (w *RecordWriter[T]) Write(record T) error {
+	if w.csv != nil {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+		row := make([]string, len(w.columns))
+		for i, column := range w.columns {
+			row[i] = w.format(column, record)
+		}
+		return w.csv.Write(row)
+	}
+
+	var line []byte
+	line = append(line, '{')
+	for i, column := range w.columns {
+		if i > 0 {
+			line = append(line, ',')
+		}
+		key, err := json.Marshal(column.Name)
+		if err != nil {
+			return err
+		}
+		line = append(line, key...)
+		line = append(line, ':')
+		value, err := w.jsonValue(column, w.format(column, record))
+		if err != nil {
+			return fmt.Errorf("column %s: %w", column.Name, err)
+		}
+		line = append(line, value...)
+	}
+	line = append(line, '}', '\n')
+	_, err := w.jsonl.Write(line)
+	return err
+}
+
+// WriteAll : writes the records
+func (w *RecordWriter[T]) WriteAll(records []T) error {
+	for _, record := range records {
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteSeq : writes the records of an iterator such as IterExecutionList, stopping at its first error
+func (w *RecordWriter[T]) WriteSeq(records iter.Seq2[T, error]) error {
+	for record, err := range records {
+		if err != nil {
+			return err
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush : writes buffered records, and the CSV header when there were none
+func (w *RecordWriter[T]) Flush() error {
+	if w.csv != nil {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return w.jsonl.Flush()
+}
+
+func (w *RecordWriter[T]) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	header := make([]string, len(w.columns))
+	for i, column := range w.columns {
+		header[i] = column.Name
+	}
+	return w.csv.Write(header)
+}
+
+func (w *RecordWriter[T]) format(column Column[T], record T) string {
+	value := column.Value(record)
+	switch column.Type {
+	case ColumnNumber:
+		if w.config.normalize {
+			return normalizeDecimal(value)
+		}
+	case ColumnTime:
+		if w.config.timeLayout == "" || value == "" {
+			return value
+		}
+		ms, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return value
+		}
+		return time.UnixMilli(ms).In(w.config.timeLocation).Format(w.config.timeLayout)
+	}
+	return value
+}
+
+func (w *RecordWriter[T]) jsonValue(column Column[T], value string) ([]byte, error) {
+	switch column.Type {
+	case ColumnNumber:
+		if value == "" {
+			return []byte("null"), nil
+		}
+		if isDecimal(value) {
+			return []byte(value), nil
+		}
+		// keep the schema stable, ".5" or "+1" are numbers as well
+		if normalized := normalizeDecimal(value); isDecimal(normalized) {
+			return []byte(normalized), nil
+		}
+		if w.config.strict {
+			return nil, fmt.Errorf("%q is not a decimal number", value)
+		}
+	case ColumnTime:
+		if value == "" {
+			return []byte("null"), nil
+		}
+		if w.config.timeLayout == "" && isDecimal(value) {
+			return []byte(value), nil
+		}
+	case ColumnBool:
+		if value == "true" || value == "false" {
+			return []byte(value), nil
+		}
+	}
+	return json.Marshal(value)
+}
+
+// isDecimal reports whether s is a JSON number without exponent
+func isDecimal(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" || (hasDot && fracPart == "") {
+		return false
+	}
+	if len(intPart) > 1 && intPart[0] == '0' {
+		return false
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// normalizeDecimal drops redundant zeros and signs, leaving anything that is not a plain decimal untouched
+func normalizeDecimal(s string) string {
+	sign := ""
+	digits := strings.TrimPrefix(s, "+")
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" {
+		return s
+	}
+	for _, r := range intPart + fracPart {
+		if r < '0' || r > '9' {
+			return s
+		}
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	fracPart = strings.TrimRight(fracPart, "0")
+	if intPart == "0" && fracPart == "" {
+		return "0"
+	}
+	if fracPart == "" {
+		return sign + intPart
+	}
+	return sign + intPart + "." + fracPart
+}
+
+func formatBool(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+func formatInt(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
+
+// KlineColumns : columns of V5GetKlineItem
+func KlineColumns() []Column[V5GetKlineItem] {
+	return []Column[V5GetKlineItem]{
+		{Name: "start", Type: ColumnTime, Value: func(k V5GetKlineItem) string { return k.StartTime }},
+		{Name: "open", Type: ColumnNumber, Value: func(k V5GetKlineItem) string { return k.Open }},
+		{Name: "high", Type: ColumnNumber, Value: func(k V5GetKlineItem) string { return k.High }},
+		{Name: "low", Type: ColumnNumber, Value: func(k V5GetKlineItem) string { return k.Low }},
+		{Name: "close", Type: ColumnNumber, Value: func(k V5GetKlineItem) string { return k.Close }},
+		{Name: "volume", Type: ColumnNumber, Value: func(k V5GetKlineItem) string { return k.Volume }},
+		{Name: "turnover", Type: ColumnNumber, Value: func(k V5GetKlineItem) string { return k.Turnover }},
+	}
+}
+
+// WebsocketKlineColumns : columns of V5WebsocketPublicKlineData, those of KlineColumns followed by the stream only fields
+func WebsocketKlineColumns() []Column[V5WebsocketPublicKlineData] {
+	return []Column[V5WebsocketPublicKlineData]{
+		{Name: "start", Type: ColumnTime, Value: func(k V5WebsocketPublicKlineData) string { return formatInt(k.Start) }},
+		{Name: "open", Type: ColumnNumber, Value: func(k V5WebsocketPublicKlineData) string { return k.Open }},
+		{Name: "high", Type: ColumnNumber, Value: func(k V5WebsocketPublicKlineData) string { return k.High }},
+		{Name: "low", Type: ColumnNumber, Value: func(k V5WebsocketPublicKlineData) string { return k.Low }},
+		{Name: "close", Type: ColumnNumber, Value: func(k V5WebsocketPublicKlineData) string { return k.Close }},
+		{Name: "volume", Type: ColumnNumber, Value: func(k V5WebsocketPublicKlineData) string { return k.Volume }},
+		{Name: "turnover", Type: ColumnNumber, Value: func(k V5WebsocketPublicKlineData) string { return k.Turnover }},
+		{Name: "end", Type: ColumnTime, Value: func(k V5WebsocketPublicKlineData) string { return formatInt(k.End) }},
+		{Name: "interval", Type: ColumnText, Value: func(k V5WebsocketPublicKlineData) string { return string(k.Interval) }},
+		{Name: "confirm", Type: ColumnBool, Value: func(k V5WebsocketPublicKlineData) string { return formatBool(k.Confirm) }},
+		{Name: "timestamp", Type: ColumnTime, Value: func(k V5WebsocketPublicKlineData) string { return formatInt(k.Timestamp) }},
+	}
+}
+
+// PublicTradeColumns : columns of V5GetPublicTradingHistoryItem
+func PublicTradeColumns() []Column[V5GetPublicTradingHistoryItem] {
+	return []Column[V5GetPublicTradingHistoryItem]{
+		{Name: "time", Type: ColumnTime, Value: func(t V5GetPublicTradingHistoryItem) string { return t.Time }},
+		{Name: "exec_id", Type: ColumnText, Value: func(t V5GetPublicTradingHistoryItem) string { return t.ExecID }},
+		{Name: "symbol", Type: ColumnText, Value: func(t V5GetPublicTradingHistoryItem) string { return string(t.Symbol) }},
+		{Name: "side", Type: ColumnText, Value: func(t V5GetPublicTradingHistoryItem) string { return string(t.Side) }},
+		{Name: "price", Type: ColumnNumber, Value: func(t V5GetPublicTradingHistoryItem) string { return t.Price }},
+		{Name: "size", Type: ColumnNumber, Value: func(t V5GetPublicTradingHistoryItem) string { return t.Size }},
+		{Name: "is_block_trade", Type: ColumnBool, Value: func(t V5GetPublicTradingHistoryItem) string { return formatBool(t.IsBlockTrade) }},
+	}
+}
+
+// WebsocketTradeColumns : columns of V5WebsocketPublicTradeData, the same as PublicTradeColumns
+func WebsocketTradeColumns() []Column[V5WebsocketPublicTradeData] {
+	return []Column[V5WebsocketPublicTradeData]{
+		{Name: "time", Type: ColumnTime, Value: func(t V5WebsocketPublicTradeData) string { return strconv.FormatUint(t.Timestamp, 10) }},
+		{Name: "exec_id", Type: ColumnText, Value: func(t V5WebsocketPublicTradeData) string { return t.ID }},
+		{Name: "symbol", Type: ColumnText, Value: func(t V5WebsocketPublicTradeData) string { return string(t.Symbol) }},
+		{Name: "side", Type: ColumnText, Value: func(t V5WebsocketPublicTradeData) string { return string(t.Side) }},
+		{Name: "price", Type: ColumnNumber, Value: func(t V5WebsocketPublicTradeData) string { return t.Trade }},
+		{Name: "size", Type: ColumnNumber, Value: func(t V5WebsocketPublicTradeData) string { return t.Value }},
+		{Name: "is_block_trade", Type: ColumnBool, Value: func(t V5WebsocketPublicTradeData) string { return formatBool(t.BlockTrade) }},
+	}
+}
+
+// ExecutionColumns : columns of V5GetExecutionListItem
+func ExecutionColumns() []Column[V5GetExecutionListItem] {
+	return []Column[V5GetExecutionListItem]{
+		{Name: "exec_time", Type: ColumnTime, Value: func(e V5GetExecutionListItem) string { return e.ExecTime }},
+		{Name: "exec_id", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return e.ExecID }},
+		{Name: "symbol", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return string(e.Symbol) }},
+		{Name: "side", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return string(e.Side) }},
+		{Name: "order_id", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return e.OrderID }},
+		{Name: "order_link_id", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return e.OrderLinkID }},
+		{Name: "order_type", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return string(e.OrderType) }},
+		{Name: "order_price", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.OrderPrice }},
+		{Name: "order_qty", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.OrderQty }},
+		{Name: "leaves_qty", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.LeavesQty }},
+		{Name: "exec_type", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return string(e.ExecType) }},
+		{Name: "exec_price", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.ExecPrice }},
+		{Name: "exec_qty", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.ExecQty }},
+		{Name: "exec_value", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.ExecValue }},
+		{Name: "exec_fee", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.ExecFee }},
+		{Name: "fee_rate", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.FeeRate }},
+		{Name: "is_maker", Type: ColumnBool, Value: func(e V5GetExecutionListItem) string { return formatBool(e.IsMaker) }},
+		{Name: "mark_price", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.MarkPrice }},
+		{Name: "index_price", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.IndexPrice }},
+		{Name: "closed_size", Type: ColumnNumber, Value: func(e V5GetExecutionListItem) string { return e.ClosedSize }},
+		{Name: "block_trade_id", Type: ColumnText, Value: func(e V5GetExecutionListItem) string { return e.BlockTradeID }},
+	}
+}
+
+// WebsocketExecutionColumns : columns of V5WebsocketPrivateExecutionData, the same as ExecutionColumns
+func WebsocketExecutionColumns() []Column[V5WebsocketPrivateExecutionData] {
+	return []Column[V5WebsocketPrivateExecutionData]{
+		{Name: "exec_time", Type: ColumnTime, Value: func(e V5WebsocketPrivateExecutionData) string { return e.ExecTime }},
+		{Name: "exec_id", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return e.ExecID }},
+		{Name: "symbol", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return string(e.Symbol) }},
+		{Name: "side", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return string(e.Side) }},
+		{Name: "order_id", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return e.OrderID }},
+		{Name: "order_link_id", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return e.OrderLinkID }},
+		{Name: "order_type", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return string(e.OrderType) }},
+		{Name: "order_price", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.OrderPrice }},
+		{Name: "order_qty", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.OrderQty }},
+		{Name: "leaves_qty", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.LeavesQty }},
+		{Name: "exec_type", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return string(e.ExecType) }},
+		{Name: "exec_price", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.ExecPrice }},
+		{Name: "exec_qty", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.ExecQty }},
+		{Name: "exec_value", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.ExecValue }},
+		{Name: "exec_fee", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.ExecFee }},
+		{Name: "fee_rate", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.FeeRate }},
+		{Name: "is_maker", Type: ColumnBool, Value: func(e V5WebsocketPrivateExecutionData) string { return formatBool(e.IsMaker) }},
+		{Name: "mark_price", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.MarkPrice }},
+		{Name: "index_price", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.IndexPrice }},
+		{Name: "closed_size", Type: ColumnNumber, Value: func(e V5WebsocketPrivateExecutionData) string { return e.ClosedSize }},
+		{Name: "block_trade_id", Type: ColumnText, Value: func(e V5WebsocketPrivateExecutionData) string { return e.BlockTradeID }},
+	}
+}
+
+// ClosedPnLColumns : columns of V5GetClosedPnLItem
+func ClosedPnLColumns() []Column[V5GetClosedPnLItem] {
+	return []Column[V5GetClosedPnLItem]{
+		{Name: "created_time", Type: ColumnTime, Value: func(p V5GetClosedPnLItem) string { return p.CreatedTime }},
+		{Name: "updated_time", Type: ColumnTime, Value: func(p V5GetClosedPnLItem) string { return p.UpdatedTime }},
+		{Name: "symbol", Type: ColumnText, Value: func(p V5GetClosedPnLItem) string { return string(p.Symbol) }},
+		{Name: "order_id", Type: ColumnText, Value: func(p V5GetClosedPnLItem) string { return p.OrderID }},
+		{Name: "side", Type: ColumnText, Value: func(p V5GetClosedPnLItem) string { return string(p.Side) }},
+		{Name: "qty", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.Qty }},
+		{Name: "order_price", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.OrderPrice }},
+		{Name: "order_type", Type: ColumnText, Value: func(p V5GetClosedPnLItem) string { return string(p.OrderType) }},
+		{Name: "exec_type", Type: ColumnText, Value: func(p V5GetClosedPnLItem) string { return string(p.ExecType) }},
+		{Name: "closed_size", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.ClosedSize }},
+		{Name: "cum_entry_value", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.CumEntryValue }},
+		{Name: "avg_entry_price", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.AvgEntryPrice }},
+		{Name: "cum_exit_value", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.CumExitValue }},
+		{Name: "avg_exit_price", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.AvgExitPrice }},
+		{Name: "closed_pnl", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.ClosedPnl }},
+		{Name: "fill_count", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.FillCount }},
+		{Name: "leverage", Type: ColumnNumber, Value: func(p V5GetClosedPnLItem) string { return p.Leverage }},
+	}
+}
+
+// TransactionLogColumns : columns of V5GetTransactionLogItem
+func TransactionLogColumns() []Column[V5GetTransactionLogItem] {
+	return []Column[V5GetTransactionLogItem]{
+		{Name: "transaction_time", Type: ColumnTime, Value: func(l V5GetTransactionLogItem) string { return l.TransactionTime }},
+		{Name: "type", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return string(l.Type) }},
+		{Name: "category", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return string(l.Category) }},
+		{Name: "symbol", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return string(l.Symbol) }},
+		{Name: "side", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return string(l.Side) }},
+		{Name: "currency", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return l.Currency }},
+		{Name: "qty", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.Qty }},
+		{Name: "size", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.Size }},
+		{Name: "trade_price", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.TradePrice }},
+		{Name: "funding", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.Funding }},
+		{Name: "fee", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.Fee }},
+		{Name: "fee_rate", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.FeeRate }},
+		{Name: "cash_flow", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.CashFlow }},
+		{Name: "change", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.Change }},
+		{Name: "cash_balance", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.CashBalance }},
+		{Name: "bonus_change", Type: ColumnNumber, Value: func(l V5GetTransactionLogItem) string { return l.BonusChange }},
+		{Name: "trade_id", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return l.TradeID }},
+		{Name: "order_id", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return l.OrderID }},
+		{Name: "order_link_id", Type: ColumnText, Value: func(l V5GetTransactionLogItem) string { return l.OrderLinkID }},
+	}
+}
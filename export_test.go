@@ -0,0 +1,133 @@
+package bybit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordWriter_CSV(t *testing.T) {
+	klines := V5GetKlineList{
+		{StartTime: "1704067200000", Open: "42000.50", High: "42100", Low: "41900.0", Close: "42050.10", Volume: "1.500", Turnover: "63000"},
+	}
+
+	t.Run("as is", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewCSVWriter(&buf, KlineColumns())
+		require.NoError(t, w.WriteAll(klines))
+		require.NoError(t, w.Flush())
+		assert.Equal(t, "start,open,high,low,close,volume,turnover\n1704067200000,42000.50,42100,41900.0,42050.10,1.500,63000\n", buf.String())
+	})
+	t.Run("time format and normalization", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewCSVWriter(&buf, KlineColumns(), WithTimeFormatOption(time.RFC3339, nil), WithNumberNormalizationOption(true))
+		require.NoError(t, w.WriteAll(klines))
+		require.NoError(t, w.Flush())
+		assert.Equal(t, "start,open,high,low,close,volume,turnover\n2024-01-01T00:00:00Z,42000.5,42100,41900,42050.1,1.5,63000\n", buf.String())
+	})
+	t.Run("header without records", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, NewCSVWriter(&buf, ClosedPnLColumns()).Flush())
+		assert.Equal(t, "created_time,updated_time,symbol,order_id,side,qty,order_price,order_type,exec_type,closed_size,cum_entry_value,avg_entry_price,cum_exit_value,avg_exit_price,closed_pnl,fill_count,leverage\n", buf.String())
+	})
+}
+
+func columnNames[T any](columns []Column[T]) []string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name
+	}
+	return names
+}
+
+func TestRecordWriter_SameHeadersForWebsocket(t *testing.T) {
+	assert.Equal(t, columnNames(PublicTradeColumns()), columnNames(WebsocketTradeColumns()))
+	assert.Equal(t, columnNames(ExecutionColumns()), columnNames(WebsocketExecutionColumns()))
+	kline := columnNames(KlineColumns())
+	assert.Equal(t, kline, columnNames(WebsocketKlineColumns())[:len(kline)])
+}
+
+func TestRecordWriter_JSONL(t *testing.T) {
+	trades := []V5WebsocketPublicTradeData{
+		{Timestamp: 1704067200123, Symbol: SymbolV5BTCUSDT, Side: SideBuy, Value: "0.010", Trade: "42000", ID: "a"},
+		{Timestamp: 1704067200456, Symbol: SymbolV5BTCUSDT, Side: SideSell, Value: "", Trade: ".5", ID: "b", BlockTrade: true},
+	}
+
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf, WebsocketTradeColumns())
+	require.NoError(t, w.WriteAll(trades))
+	require.NoError(t, w.Flush())
+	assert.Equal(t,
+		`{"time":1704067200123,"exec_id":"a","symbol":"BTCUSDT","side":"Buy","price":42000,"size":0.010,"is_block_trade":false}`+"\n"+
+			`{"time":1704067200456,"exec_id":"b","symbol":"BTCUSDT","side":"Sell","price":0.5,"size":null,"is_block_trade":true}`+"\n",
+		buf.String())
+
+	buf.Reset()
+	w = NewJSONLWriter(&buf, WebsocketTradeColumns(), WithTimeFormatOption(time.RFC3339Nano, time.UTC), WithNumberNormalizationOption(true))
+	require.NoError(t, w.WriteAll(trades[1:]))
+	require.NoError(t, w.Flush())
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "2024-01-01T00:00:00.456Z", line["time"])
+	assert.Equal(t, 0.5, line["price"])
+
+}
+
+func TestRecordWriter_NotADecimal(t *testing.T) {
+	trade := V5WebsocketPublicTradeData{Timestamp: 1704067200123, ID: "a", Trade: "1e-5", Value: "n/a"}
+
+	var buf bytes.Buffer
+	csvWriter := NewCSVWriter(&buf, WebsocketTradeColumns(), WithNumberNormalizationOption(true))
+	require.NoError(t, csvWriter.Write(trade))
+	require.NoError(t, csvWriter.Flush())
+	assert.Contains(t, buf.String(), ",1e-5,n/a,")
+
+	buf.Reset()
+	jsonlWriter := NewJSONLWriter(&buf, WebsocketTradeColumns())
+	require.NoError(t, jsonlWriter.Write(trade))
+	require.NoError(t, jsonlWriter.Flush())
+	assert.Contains(t, buf.String(), `"price":"1e-5","size":"n/a"`)
+
+	buf.Reset()
+	jsonlWriter = NewJSONLWriter(&buf, WebsocketTradeColumns(), WithStrictNumbersOption(true))
+	err := jsonlWriter.Write(trade)
+	assert.ErrorContains(t, err, `column price: "1e-5" is not a decimal number`)
+}
+
+func TestRecordWriter_WriteSeq(t *testing.T) {
+	failure := errors.New("failure")
+	seq := func(yield func(V5GetTransactionLogItem, error) bool) {
+		if !yield(V5GetTransactionLogItem{TransactionTime: "1", Change: "-1.50"}, nil) {
+			return
+		}
+		yield(V5GetTransactionLogItem{}, failure)
+	}
+
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf, TransactionLogColumns(), WithNumberNormalizationOption(true))
+	err := w.WriteSeq(seq)
+	require.ErrorIs(t, err, failure)
+	require.NoError(t, w.Flush())
+	assert.Contains(t, buf.String(), "\n1,,,,,,,,,,,,,-1.5,,,,,\n")
+}
+
+func TestNormalizeDecimal(t *testing.T) {
+	for in, want := range map[string]string{
+		"0.0100":  "0.01",
+		"+1.000":  "1",
+		".5":      "0.5",
+		"-0.000":  "0",
+		"007":     "7",
+		"-12.340": "-12.34",
+		"":        "",
+		"1e-5":    "1e-5",
+		"abc":     "abc",
+	} {
+		assert.Equal(t, want, normalizeDecimal(in), in)
+	}
+}
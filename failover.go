@@ -0,0 +1,365 @@
+package bybit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// DefaultFailoverCooldown : how long a failed host is avoided unless a health check succeeds earlier
+const DefaultFailoverCooldown = 30 * time.Second
+
+// Failover : spreads a client over equivalent hosts, e.g. MainNetBaseURL and MainNetBaseURL2
+// The preferred host is the healthy one with the lowest latency measured by the health checks,
+// the order given to NewFailover breaks ties and applies before the first check.
+type Failover struct {
+	cooldown time.Duration
+
+	mu        sync.Mutex
+	endpoints []*failoverEndpoint
+}
+
+type failoverEndpoint struct {
+	baseURL   string
+	latency   time.Duration
+	checkedAt time.Time
+	failedAt  time.Time
+	lastErr   error
+}
+
+// FailoverStatus :
+type FailoverStatus struct {
+	BaseURL string
+	Healthy bool
+	// Latency is the round trip of the last successful health check, zero before
+	Latency   time.Duration
+	CheckedAt time.Time
+	FailedAt  time.Time
+	LastError error
+}
+
+// NewFailover :
+func NewFailover(baseURLs ...string) *Failover {
+	f := &Failover{cooldown: DefaultFailoverCooldown}
+	for _, baseURL := range baseURLs {
+		f.endpoints = append(f.endpoints, &failoverEndpoint{baseURL: baseURL})
+	}
+	return f
+}
+
+// WithCooldown :
+func (f *Failover) WithCooldown(cooldown time.Duration) *Failover {
+	f.cooldown = cooldown
+
+	return f
+}
+
+func (f *Failover) healthy(e *failoverEndpoint, now time.Time) bool {
+	return e.failedAt.IsZero() || now.Sub(e.failedAt) >= f.cooldown
+}
+
+// BaseURL : the preferred host
+func (f *Failover) BaseURL() string {
+	candidates := f.candidates()
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0]
+}
+
+// candidates returns the hosts in order of preference, unhealthy ones last, the longest failed first
+func (f *Failover) candidates() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	var healthy, unhealthy []*failoverEndpoint
+	for _, e := range f.endpoints {
+		if f.healthy(e, now) {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+	// insertion sorts keep the configured order for ties
+	for i := 1; i < len(healthy); i++ {
+		for j := i; j > 0 && lessLatency(healthy[j], healthy[j-1]); j-- {
+			healthy[j], healthy[j-1] = healthy[j-1], healthy[j]
+		}
+	}
+	for i := 1; i < len(unhealthy); i++ {
+		for j := i; j > 0 && unhealthy[j].failedAt.Before(unhealthy[j-1].failedAt); j-- {
+			unhealthy[j], unhealthy[j-1] = unhealthy[j-1], unhealthy[j]
+		}
+	}
+
+	result := make([]string, 0, len(f.endpoints))
+	for _, e := range append(healthy, unhealthy...) {
+		result = append(result, e.baseURL)
+	}
+	return result
+}
+
+// lessLatency orders measured hosts by latency, unmeasured hosts keep their place after them
+func lessLatency(a, b *failoverEndpoint) bool {
+	switch {
+	case a.latency == 0:
+		return false
+	case b.latency == 0:
+		return true
+	default:
+		return a.latency < b.latency
+	}
+}
+
+// Status : the state of every host in the configured order
+func (f *Failover) Status() []FailoverStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	result := make([]FailoverStatus, 0, len(f.endpoints))
+	for _, e := range f.endpoints {
+		result = append(result, FailoverStatus{
+			BaseURL:   e.baseURL,
+			Healthy:   f.healthy(e, now),
+			Latency:   e.latency,
+			CheckedAt: e.checkedAt,
+			FailedAt:  e.failedAt,
+			LastError: e.lastErr,
+		})
+	}
+	return result
+}
+
+func (f *Failover) size() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.endpoints)
+}
+
+func (f *Failover) endpoint(baseURL string) *failoverEndpoint {
+	for _, e := range f.endpoints {
+		if e.baseURL == baseURL {
+			return e
+		}
+	}
+	return nil
+}
+
+func (f *Failover) reportFailure(baseURL string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if e := f.endpoint(baseURL); e != nil {
+		e.failedAt = time.Now()
+		e.lastErr = err
+	}
+}
+
+// reportCheck records a health check, a nil error marks the host healthy again
+func (f *Failover) reportCheck(baseURL string, latency time.Duration, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	e := f.endpoint(baseURL)
+	if e == nil {
+		return
+	}
+	e.checkedAt = time.Now()
+	if err != nil {
+		e.failedAt = e.checkedAt
+		e.lastErr = err
+		return
+	}
+	e.latency = latency
+	e.failedAt = time.Time{}
+	e.lastErr = nil
+}
+
+// isFailoverError reports whether another host may succeed
+func isFailoverError(err error) bool {
+	return isNetworkError(err) || errors.Is(err, ErrServerError)
+}
+
+// WithFailover : sends V5 and public requests to the preferred host of the failover instead of the base URL
+// On network errors and 5xx responses, requests that are safe to retry move to the next host right away,
+// see RetryPolicy for which requests are safe to retry.
+func (c *Client) WithFailover(failover *Failover) *Client {
+	c.failover = failover
+
+	return c
+}
+
+// WithFailoverOption :
+func WithFailoverOption(failover *Failover) Option {
+	var scheme string
+	if failover != nil && failover.size() > 0 {
+		scheme = baseURLScheme(failover.endpoints[0].baseURL)
+	}
+	return Option{
+		name:   "failover",
+		scheme: scheme,
+		client: func(c *Client) error {
+			if failover == nil || failover.size() == 0 {
+				return errors.New("no base url")
+			}
+			for _, e := range failover.endpoints {
+				if err := validateBaseURL(e.baseURL, "http", "https"); err != nil {
+					return err
+				}
+			}
+			c.WithFailover(failover)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			if failover == nil || failover.size() == 0 {
+				return errors.New("no base url")
+			}
+			for _, e := range failover.endpoints {
+				if err := validateBaseURL(e.baseURL, "ws", "wss"); err != nil {
+					return err
+				}
+			}
+			c.WithFailover(failover)
+			return nil
+		},
+	}
+}
+
+func (c *Client) currentBaseURL() string {
+	if c.failover != nil {
+		return c.failover.BaseURL()
+	}
+	return c.baseURL
+}
+
+// requestURL joins the current base URL with path and query
+func (c *Client) requestURL(path string, query url.Values) (*url.URL, error) {
+	u, err := url.Parse(c.currentBaseURL())
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path
+	u.RawQuery = query.Encode()
+	return u, nil
+}
+
+// baseURLOf strips path and query, the reverse of requestURL
+func baseURLOf(u *url.URL) string {
+	return (&url.URL{Scheme: u.Scheme, User: u.User, Host: u.Host}).String()
+}
+
+// CheckFailoverHealth : measures every host of the failover with /v5/market/time
+func (c *Client) CheckFailoverHealth(ctx context.Context) error {
+	if c.failover == nil {
+		return errors.New("no failover configured")
+	}
+
+	var errs []error
+	for _, baseURL := range c.failover.candidates() {
+		start := time.Now()
+		err := c.checkHealth(ctx, baseURL)
+		c.failover.reportCheck(baseURL, time.Since(start), err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", baseURL, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Client) checkHealth(ctx context.Context, baseURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/v5/market/time", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// StartFailoverHealthCheck checks the hosts once and then keeps checking on interval until ctx is done.
+// Errors are passed to errHandler when given, the initial check returns an error only when every host failed.
+func (c *Client) StartFailoverHealthCheck(ctx context.Context, interval time.Duration, errHandler func(error)) error {
+	if interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if err := c.CheckFailoverHealth(ctx); err != nil {
+		healthy := false
+		for _, status := range c.failover.Status() {
+			healthy = healthy || status.Healthy
+		}
+		if !healthy {
+			return err
+		}
+		if errHandler != nil {
+			errHandler(err)
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.CheckFailoverHealth(ctx); err != nil && errHandler != nil && ctx.Err() == nil {
+					errHandler(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// WithFailover : dials the preferred host of the failover, moving to the next one when the dial fails
+// The dial latency ranks the hosts, so every reconnect goes to the fastest healthy host.
+func (c *WebSocketClient) WithFailover(failover *Failover) *WebSocketClient {
+	c.failover = failover
+
+	return c
+}
+
+// dial connects to path on the base URL, or on the hosts of the failover in order of preference
+func (c *WebSocketClient) dial(baseURL string, path string, service WebsocketService, category CategoryV5) (*websocket.Conn, error) {
+	dialer := c.dialer
+	if dialer == nil {
+		dialer = websocket.DefaultDialer
+	}
+
+	if c.failover == nil {
+		conn, _, err := dialer.Dial(baseURL+path, nil)
+		c.observeConnect(service, category, err)
+		return conn, err
+	}
+
+	var errs []error
+	for _, baseURL := range c.failover.candidates() {
+		start := time.Now()
+		conn, _, err := dialer.Dial(baseURL+path, nil)
+		c.observeConnect(service, category, err)
+		c.failover.reportCheck(baseURL, time.Since(start), err)
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", baseURL, err))
+	}
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,163 @@
+package bybit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithFailover(t *testing.T) {
+	okBody := map[string]interface{}{
+		"retCode": 0,
+		"result": map[string]interface{}{
+			"timeSecond": "1688639403",
+			"timeNano":   "1688639403423213947",
+		},
+	}
+
+	t.Run("get moves to the next host", func(t *testing.T) {
+		var badCalls, goodCalls int32
+		bad, teardownBad := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/market/time", &badCalls,
+				respondJSON(t, http.StatusBadGateway, nil, map[string]interface{}{}),
+			),
+		)
+		defer teardownBad()
+		good, teardownGood := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/market/time", &goodCalls,
+				respondJSON(t, http.StatusOK, nil, okBody),
+			),
+		)
+		defer teardownGood()
+
+		failover := NewFailover(bad.URL, good.URL)
+		client := NewTestClient().WithFailover(failover)
+
+		_, err := client.V5().Market().GetServerTime()
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), badCalls)
+		assert.Equal(t, int32(1), goodCalls)
+		assert.Equal(t, good.URL, failover.BaseURL())
+
+		// the failed host is skipped until the cooldown ends
+		_, err = client.V5().Market().GetServerTime()
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), badCalls)
+		assert.Equal(t, int32(2), goodCalls)
+
+		status := failover.Status()
+		require.Len(t, status, 2)
+		assert.False(t, status[0].Healthy)
+		assert.ErrorIs(t, status[0].LastError, ErrServerError)
+		assert.True(t, status[1].Healthy)
+	})
+
+	t.Run("post without order link id stays", func(t *testing.T) {
+		var badCalls, goodCalls int32
+		bad, teardownBad := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &badCalls,
+				respondJSON(t, http.StatusBadGateway, nil, map[string]interface{}{}),
+			),
+		)
+		defer teardownBad()
+		good, teardownGood := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &goodCalls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardownGood()
+
+		failover := NewFailover(bad.URL, good.URL)
+		client := NewTestClient().
+			WithAuth("test", "test").
+			WithFailover(failover)
+
+		_, err := client.V5().Order().CreateOrder(V5CreateOrderParam{
+			Category:  CategoryV5Linear,
+			Symbol:    SymbolV5BTCUSDT,
+			Side:      SideBuy,
+			OrderType: OrderTypeMarket,
+			Qty:       "0.01",
+		})
+		assert.ErrorIs(t, err, ErrServerError)
+		assert.Equal(t, int32(1), badCalls)
+		assert.Equal(t, int32(0), goodCalls)
+		// the failure still counts, the next request goes to the other host
+		assert.Equal(t, good.URL, failover.BaseURL())
+	})
+
+	t.Run("option validates urls", func(t *testing.T) {
+		_, err := NewV5Client(WithFailoverOption(NewFailover(WebsocketBaseURL, WebsocketBaseURL2)))
+		assert.ErrorContains(t, err, "scheme must be one of [http https]")
+
+		_, err = NewV5WebsocketClient(WithFailoverOption(NewFailover()))
+		assert.ErrorContains(t, err, "failover: no base url")
+
+		client, err := NewV5Client(WithFailoverOption(NewFailover(MainNetBaseURL, MainNetBaseURL2)))
+		require.NoError(t, err)
+		assert.Equal(t, MainNetBaseURL, client.currentBaseURL())
+	})
+}
+
+func TestClient_CheckFailoverHealth(t *testing.T) {
+	var slowCalls, fastCalls int32
+	slow, teardownSlow := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/market/time", &slowCalls,
+			func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(50 * time.Millisecond)
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer teardownSlow()
+	fast, teardownFast := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/market/time", &fastCalls,
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 0}),
+		),
+	)
+	defer teardownFast()
+
+	failover := NewFailover(slow.URL, fast.URL, "http://127.0.0.1:1")
+	client := NewTestClient().WithFailover(failover)
+
+	err := client.CheckFailoverHealth(context.Background())
+	assert.ErrorContains(t, err, "http://127.0.0.1:1")
+	assert.Equal(t, fast.URL, failover.BaseURL())
+	assert.Equal(t, []string{fast.URL, slow.URL, "http://127.0.0.1:1"}, failover.candidates())
+
+	status := failover.Status()
+	require.Len(t, status, 3)
+	assert.Greater(t, status[0].Latency, status[1].Latency)
+	assert.False(t, status[2].Healthy)
+
+	err = NewTestClient().CheckFailoverHealth(context.Background())
+	assert.Error(t, err)
+}
+
+func TestWebSocketClient_WithFailover(t *testing.T) {
+	category := CategoryV5Linear
+	server, teardown := testhelper.NewWebsocketServer(
+		testhelper.WithWebsocketHandlerOption(V5WebsocketPublicPathFor(category), []byte(`{}`)),
+	)
+	defer teardown()
+
+	metrics := NewPrometheusMetrics()
+	failover := NewFailover("ws://127.0.0.1:1", server.URL)
+	wsClient := NewTestWebsocketClient().
+		WithMetrics(metrics).
+		WithFailover(failover)
+
+	svc, err := wsClient.V5().Public(category)
+	require.NoError(t, err)
+	require.NoError(t, svc.Close())
+	assert.Equal(t, server.URL, failover.BaseURL())
+
+	out := scrapeMetrics(t, metrics)
+	assert.Contains(t, out, `bybit_websocket_connects_total{service="public",category="linear",result="error"} 1`)
+	assert.Contains(t, out, `bybit_websocket_connects_total{service="public",category="linear",result="ok"} 1`)
+}
@@ -0,0 +1,15 @@
+// Package fakes provides programmable in-memory implementations of the V5 service interfaces.
+//
+// Every fake records its calls, returns what Returns configured for a method or delegates to
+// the method's Stub field, and otherwise returns zero values. V5Service hands out the other fakes.
+//
+//	svc := fakes.NewV5Service()
+//	svc.OrderService.Returns("CreateOrder", &bybit.V5CreateOrderResponse{}, nil)
+//	strategy := NewStrategy(svc)
+//	...
+//	fakes.AssertCalled(t, svc.OrderService, "CreateOrder", bybit.V5CreateOrderParam{...})
+//
+// The fakes are generated from the interfaces with go generate, a test fails when they are stale.
+package fakes
+
+//go:generate go run ./internal/fakegen/cmd fakes_gen.go
@@ -0,0 +1,201 @@
+package fakes
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Call : a recorded method call
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Any : matches any argument in AssertCalled and CallsWith
+var Any = anyArg{}
+
+type anyArg struct{}
+
+// Recorder : records the calls of a fake and holds its configured return values
+// It is embedded in every fake, the zero value is ready to use.
+type Recorder struct {
+	mu      sync.Mutex
+	calls   []Call
+	results map[string][]interface{}
+}
+
+// Recorded : implemented by every fake through Recorder
+type Recorded interface {
+	Calls() []Call
+}
+
+// Returns : makes method return values, in the order of its results, until changed
+// A nil value leaves the zero value of its result, e.g. Returns("CancelOrder", nil, err).
+func (r *Recorder) Returns(method string, values ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.results == nil {
+		r.results = map[string][]interface{}{}
+	}
+	r.results[method] = values
+}
+
+// Calls : every recorded call in order
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call{}, r.calls...)
+}
+
+// CallsTo : the recorded calls of method in order
+func (r *Recorder) CallsTo(method string) []Call {
+	return callsTo(r, method)
+}
+
+// CallCount : how many times method has been called
+func (r *Recorder) CallCount(method string) int {
+	return len(r.CallsTo(method))
+}
+
+// Reset : forgets the recorded calls and configured return values
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+	r.results = nil
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// result copies the configured return values of method into dst, reporting whether there were any
+func (r *Recorder) result(method string, dst ...interface{}) bool {
+	r.mu.Lock()
+	values, ok := r.results[method]
+	r.mu.Unlock()
+	if !ok {
+		return false
+	}
+	if len(values) != len(dst) {
+		panic(fmt.Sprintf("fakes: %s returns %d values, %d configured", method, len(dst), len(values)))
+	}
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		target := reflect.ValueOf(dst[i]).Elem()
+		v := reflect.ValueOf(value)
+		if !v.Type().AssignableTo(target.Type()) {
+			panic(fmt.Sprintf("fakes: %s result %d is %s, configured %s", method, i, target.Type(), v.Type()))
+		}
+		target.Set(v)
+	}
+	return true
+}
+
+func matchArgs(want, got []interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		if want[i] == Any {
+			continue
+		}
+		if !reflect.DeepEqual(want[i], got[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// CallsWith : the recorded calls of method whose arguments deep equal args, Any matching anything
+func CallsWith(fake Recorded, method string, args ...interface{}) []Call {
+	var result []Call
+	for _, call := range fake.Calls() {
+		if call.Method == method && matchArgs(args, call.Args) {
+			result = append(result, call)
+		}
+	}
+	return result
+}
+
+// TestingT : the subset of testing.T used by the assertions
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// AssertCalled : fails unless method has been called with args, Any matching anything
+func AssertCalled(t TestingT, fake Recorded, method string, args ...interface{}) bool {
+	t.Helper()
+
+	if len(CallsWith(fake, method, args...)) > 0 {
+		return true
+	}
+	t.Errorf("fakes: %s not called with %s\ncalls:\n%s", method, formatArgs(args), formatCalls(fake.Calls()))
+	return false
+}
+
+// AssertNotCalled : fails if method has been called
+func AssertNotCalled(t TestingT, fake Recorded, method string) bool {
+	t.Helper()
+
+	if calls := callsTo(fake, method); len(calls) > 0 {
+		t.Errorf("fakes: %s called %d times\ncalls:\n%s", method, len(calls), formatCalls(calls))
+		return false
+	}
+	return true
+}
+
+// AssertCallCount : fails unless method has been called n times
+func AssertCallCount(t TestingT, fake Recorded, method string, n int) bool {
+	t.Helper()
+
+	if calls := callsTo(fake, method); len(calls) != n {
+		t.Errorf("fakes: %s called %d times, want %d\ncalls:\n%s", method, len(calls), n, formatCalls(fake.Calls()))
+		return false
+	}
+	return true
+}
+
+func callsTo(fake Recorded, method string) []Call {
+	var result []Call
+	for _, call := range fake.Calls() {
+		if call.Method == method {
+			result = append(result, call)
+		}
+	}
+	return result
+}
+
+func formatArgs(args []interface{}) string {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == Any {
+			parts = append(parts, "Any")
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%+v", arg))
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func formatCalls(calls []Call) string {
+	if len(calls) == 0 {
+		return "\t(none)"
+	}
+	lines := make([]string, 0, len(calls))
+	for _, call := range calls {
+		lines = append(lines, "\t"+call.Method+formatArgs(call.Args))
+	}
+	return strings.Join(lines, "\n")
+}
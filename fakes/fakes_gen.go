@@ -0,0 +1,2028 @@
+// Code generated by fakegen. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+
+	"github.com/hirokisan/bybit/v2"
+)
+
+// V5Service : fake of bybit.V5ServiceI
+type V5Service struct {
+	Recorder
+
+	AccountStub           func() bybit.V5AccountServiceI
+	AssetStub             func() bybit.V5AssetServiceI
+	ExecutionStub         func() bybit.V5ExecutionServiceI
+	MarketStub            func() bybit.V5MarketServiceI
+	OrderStub             func() bybit.V5OrderServiceI
+	PositionStub          func() bybit.V5PositionServiceI
+	SpotLeverageTokenStub func() bybit.V5SpotLeverageTokenServiceI
+	SpotMarginTradeStub   func() bybit.V5SpotMarginTradeServiceI
+	UserStub              func() bybit.V5UserServiceI
+
+	AccountService           *V5AccountService
+	AssetService             *V5AssetService
+	ExecutionService         *V5ExecutionService
+	MarketService            *V5MarketService
+	OrderService             *V5OrderService
+	PositionService          *V5PositionService
+	SpotLeverageTokenService *V5SpotLeverageTokenService
+	SpotMarginTradeService   *V5SpotMarginTradeService
+	UserService              *V5UserService
+}
+
+var _ bybit.V5ServiceI = (*V5Service)(nil)
+
+// NewV5Service : a V5Service handing out new fakes
+func NewV5Service() *V5Service {
+	return &V5Service{
+		AccountService:           &V5AccountService{},
+		AssetService:             &V5AssetService{},
+		ExecutionService:         &V5ExecutionService{},
+		MarketService:            &V5MarketService{},
+		OrderService:             &V5OrderService{},
+		PositionService:          &V5PositionService{},
+		SpotLeverageTokenService: &V5SpotLeverageTokenService{},
+		SpotMarginTradeService:   &V5SpotMarginTradeService{},
+		UserService:              &V5UserService{},
+	}
+}
+
+// Account :
+func (f *V5Service) Account() bybit.V5AccountServiceI {
+	f.record("Account")
+	if f.AccountStub != nil {
+		return f.AccountStub()
+	}
+	var r0 bybit.V5AccountServiceI
+	if !f.result("Account", &r0) && f.AccountService != nil {
+		r0 = f.AccountService
+	}
+	return r0
+}
+
+// Asset :
+func (f *V5Service) Asset() bybit.V5AssetServiceI {
+	f.record("Asset")
+	if f.AssetStub != nil {
+		return f.AssetStub()
+	}
+	var r0 bybit.V5AssetServiceI
+	if !f.result("Asset", &r0) && f.AssetService != nil {
+		r0 = f.AssetService
+	}
+	return r0
+}
+
+// Execution :
+func (f *V5Service) Execution() bybit.V5ExecutionServiceI {
+	f.record("Execution")
+	if f.ExecutionStub != nil {
+		return f.ExecutionStub()
+	}
+	var r0 bybit.V5ExecutionServiceI
+	if !f.result("Execution", &r0) && f.ExecutionService != nil {
+		r0 = f.ExecutionService
+	}
+	return r0
+}
+
+// Market :
+func (f *V5Service) Market() bybit.V5MarketServiceI {
+	f.record("Market")
+	if f.MarketStub != nil {
+		return f.MarketStub()
+	}
+	var r0 bybit.V5MarketServiceI
+	if !f.result("Market", &r0) && f.MarketService != nil {
+		r0 = f.MarketService
+	}
+	return r0
+}
+
+// Order :
+func (f *V5Service) Order() bybit.V5OrderServiceI {
+	f.record("Order")
+	if f.OrderStub != nil {
+		return f.OrderStub()
+	}
+	var r0 bybit.V5OrderServiceI
+	if !f.result("Order", &r0) && f.OrderService != nil {
+		r0 = f.OrderService
+	}
+	return r0
+}
+
+// Position :
+func (f *V5Service) Position() bybit.V5PositionServiceI {
+	f.record("Position")
+	if f.PositionStub != nil {
+		return f.PositionStub()
+	}
+	var r0 bybit.V5PositionServiceI
+	if !f.result("Position", &r0) && f.PositionService != nil {
+		r0 = f.PositionService
+	}
+	return r0
+}
+
+// SpotLeverageToken :
+func (f *V5Service) SpotLeverageToken() bybit.V5SpotLeverageTokenServiceI {
+	f.record("SpotLeverageToken")
+	if f.SpotLeverageTokenStub != nil {
+		return f.SpotLeverageTokenStub()
+	}
+	var r0 bybit.V5SpotLeverageTokenServiceI
+	if !f.result("SpotLeverageToken", &r0) && f.SpotLeverageTokenService != nil {
+		r0 = f.SpotLeverageTokenService
+	}
+	return r0
+}
+
+// SpotMarginTrade :
+func (f *V5Service) SpotMarginTrade() bybit.V5SpotMarginTradeServiceI {
+	f.record("SpotMarginTrade")
+	if f.SpotMarginTradeStub != nil {
+		return f.SpotMarginTradeStub()
+	}
+	var r0 bybit.V5SpotMarginTradeServiceI
+	if !f.result("SpotMarginTrade", &r0) && f.SpotMarginTradeService != nil {
+		r0 = f.SpotMarginTradeService
+	}
+	return r0
+}
+
+// User :
+func (f *V5Service) User() bybit.V5UserServiceI {
+	f.record("User")
+	if f.UserStub != nil {
+		return f.UserStub()
+	}
+	var r0 bybit.V5UserServiceI
+	if !f.result("User", &r0) && f.UserService != nil {
+		r0 = f.UserService
+	}
+	return r0
+}
+
+// V5MarketService : fake of bybit.V5MarketServiceI
+type V5MarketService struct {
+	Recorder
+
+	GetFundingRateHistoryStub                func(bybit.V5GetFundingRateHistoryParam) (*bybit.V5GetFundingRateHistoryResponse, error)
+	GetFundingRateHistoryWithContextStub     func(context.Context, bybit.V5GetFundingRateHistoryParam) (*bybit.V5GetFundingRateHistoryResponse, error)
+	GetHistoricalVolatilityStub              func(bybit.V5GetHistoricalVolatilityParam) (*bybit.V5GetHistoricalVolatilityResponse, error)
+	GetHistoricalVolatilityWithContextStub   func(context.Context, bybit.V5GetHistoricalVolatilityParam) (*bybit.V5GetHistoricalVolatilityResponse, error)
+	GetIndexPriceKlineStub                   func(bybit.V5GetIndexPriceKlineParam) (*bybit.V5GetIndexPriceKlineResponse, error)
+	GetIndexPriceKlineWithContextStub        func(context.Context, bybit.V5GetIndexPriceKlineParam) (*bybit.V5GetIndexPriceKlineResponse, error)
+	GetInstrumentsInfoStub                   func(bybit.V5GetInstrumentsInfoParam) (*bybit.V5GetInstrumentsInfoResponse, error)
+	GetInstrumentsInfoWithContextStub        func(context.Context, bybit.V5GetInstrumentsInfoParam) (*bybit.V5GetInstrumentsInfoResponse, error)
+	GetInsuranceStub                         func(bybit.V5GetInsuranceParam) (*bybit.V5GetInsuranceResponse, error)
+	GetInsuranceWithContextStub              func(context.Context, bybit.V5GetInsuranceParam) (*bybit.V5GetInsuranceResponse, error)
+	GetKlineStub                             func(bybit.V5GetKlineParam) (*bybit.V5GetKlineResponse, error)
+	GetKlineWithContextStub                  func(context.Context, bybit.V5GetKlineParam) (*bybit.V5GetKlineResponse, error)
+	GetMarkPriceKlineStub                    func(bybit.V5GetMarkPriceKlineParam) (*bybit.V5GetMarkPriceKlineResponse, error)
+	GetMarkPriceKlineWithContextStub         func(context.Context, bybit.V5GetMarkPriceKlineParam) (*bybit.V5GetMarkPriceKlineResponse, error)
+	GetOpenInterestStub                      func(bybit.V5GetOpenInterestParam) (*bybit.V5GetOpenInterestResponse, error)
+	GetOpenInterestWithContextStub           func(context.Context, bybit.V5GetOpenInterestParam) (*bybit.V5GetOpenInterestResponse, error)
+	GetOrderbookStub                         func(bybit.V5GetOrderbookParam) (*bybit.V5GetOrderbookResponse, error)
+	GetOrderbookWithContextStub              func(context.Context, bybit.V5GetOrderbookParam) (*bybit.V5GetOrderbookResponse, error)
+	GetPremiumIndexPriceKlineStub            func(bybit.V5GetPremiumIndexPriceKlineParam) (*bybit.V5GetPremiumIndexPriceKlineResponse, error)
+	GetPremiumIndexPriceKlineWithContextStub func(context.Context, bybit.V5GetPremiumIndexPriceKlineParam) (*bybit.V5GetPremiumIndexPriceKlineResponse, error)
+	GetPublicTradingHistoryStub              func(bybit.V5GetPublicTradingHistoryParam) (*bybit.V5GetPublicTradingHistoryResponse, error)
+	GetPublicTradingHistoryWithContextStub   func(context.Context, bybit.V5GetPublicTradingHistoryParam) (*bybit.V5GetPublicTradingHistoryResponse, error)
+	GetRiskLimitStub                         func(bybit.V5GetRiskLimitParam) (*bybit.V5GetRiskLimitResponse, error)
+	GetRiskLimitWithContextStub              func(context.Context, bybit.V5GetRiskLimitParam) (*bybit.V5GetRiskLimitResponse, error)
+	GetServerTimeStub                        func() (*bybit.V5GetServerTimeResponse, error)
+	GetServerTimeWithContextStub             func(context.Context) (*bybit.V5GetServerTimeResponse, error)
+	GetTickersStub                           func(bybit.V5GetTickersParam) (*bybit.V5GetTickersResponse, error)
+	GetTickersWithContextStub                func(context.Context, bybit.V5GetTickersParam) (*bybit.V5GetTickersResponse, error)
+}
+
+var _ bybit.V5MarketServiceI = (*V5MarketService)(nil)
+
+// GetFundingRateHistory :
+func (f *V5MarketService) GetFundingRateHistory(a0 bybit.V5GetFundingRateHistoryParam) (*bybit.V5GetFundingRateHistoryResponse, error) {
+	f.record("GetFundingRateHistory", a0)
+	if f.GetFundingRateHistoryStub != nil {
+		return f.GetFundingRateHistoryStub(a0)
+	}
+	var r0 *bybit.V5GetFundingRateHistoryResponse
+	var r1 error
+	f.result("GetFundingRateHistory", &r0, &r1)
+	return r0, r1
+}
+
+// GetFundingRateHistoryWithContext :
+func (f *V5MarketService) GetFundingRateHistoryWithContext(a0 context.Context, a1 bybit.V5GetFundingRateHistoryParam) (*bybit.V5GetFundingRateHistoryResponse, error) {
+	f.record("GetFundingRateHistoryWithContext", a0, a1)
+	if f.GetFundingRateHistoryWithContextStub != nil {
+		return f.GetFundingRateHistoryWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetFundingRateHistoryResponse
+	var r1 error
+	f.result("GetFundingRateHistoryWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetHistoricalVolatility :
+func (f *V5MarketService) GetHistoricalVolatility(a0 bybit.V5GetHistoricalVolatilityParam) (*bybit.V5GetHistoricalVolatilityResponse, error) {
+	f.record("GetHistoricalVolatility", a0)
+	if f.GetHistoricalVolatilityStub != nil {
+		return f.GetHistoricalVolatilityStub(a0)
+	}
+	var r0 *bybit.V5GetHistoricalVolatilityResponse
+	var r1 error
+	f.result("GetHistoricalVolatility", &r0, &r1)
+	return r0, r1
+}
+
+// GetHistoricalVolatilityWithContext :
+func (f *V5MarketService) GetHistoricalVolatilityWithContext(a0 context.Context, a1 bybit.V5GetHistoricalVolatilityParam) (*bybit.V5GetHistoricalVolatilityResponse, error) {
+	f.record("GetHistoricalVolatilityWithContext", a0, a1)
+	if f.GetHistoricalVolatilityWithContextStub != nil {
+		return f.GetHistoricalVolatilityWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetHistoricalVolatilityResponse
+	var r1 error
+	f.result("GetHistoricalVolatilityWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetIndexPriceKline :
+func (f *V5MarketService) GetIndexPriceKline(a0 bybit.V5GetIndexPriceKlineParam) (*bybit.V5GetIndexPriceKlineResponse, error) {
+	f.record("GetIndexPriceKline", a0)
+	if f.GetIndexPriceKlineStub != nil {
+		return f.GetIndexPriceKlineStub(a0)
+	}
+	var r0 *bybit.V5GetIndexPriceKlineResponse
+	var r1 error
+	f.result("GetIndexPriceKline", &r0, &r1)
+	return r0, r1
+}
+
+// GetIndexPriceKlineWithContext :
+func (f *V5MarketService) GetIndexPriceKlineWithContext(a0 context.Context, a1 bybit.V5GetIndexPriceKlineParam) (*bybit.V5GetIndexPriceKlineResponse, error) {
+	f.record("GetIndexPriceKlineWithContext", a0, a1)
+	if f.GetIndexPriceKlineWithContextStub != nil {
+		return f.GetIndexPriceKlineWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetIndexPriceKlineResponse
+	var r1 error
+	f.result("GetIndexPriceKlineWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetInstrumentsInfo :
+func (f *V5MarketService) GetInstrumentsInfo(a0 bybit.V5GetInstrumentsInfoParam) (*bybit.V5GetInstrumentsInfoResponse, error) {
+	f.record("GetInstrumentsInfo", a0)
+	if f.GetInstrumentsInfoStub != nil {
+		return f.GetInstrumentsInfoStub(a0)
+	}
+	var r0 *bybit.V5GetInstrumentsInfoResponse
+	var r1 error
+	f.result("GetInstrumentsInfo", &r0, &r1)
+	return r0, r1
+}
+
+// GetInstrumentsInfoWithContext :
+func (f *V5MarketService) GetInstrumentsInfoWithContext(a0 context.Context, a1 bybit.V5GetInstrumentsInfoParam) (*bybit.V5GetInstrumentsInfoResponse, error) {
+	f.record("GetInstrumentsInfoWithContext", a0, a1)
+	if f.GetInstrumentsInfoWithContextStub != nil {
+		return f.GetInstrumentsInfoWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetInstrumentsInfoResponse
+	var r1 error
+	f.result("GetInstrumentsInfoWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetInsurance :
+func (f *V5MarketService) GetInsurance(a0 bybit.V5GetInsuranceParam) (*bybit.V5GetInsuranceResponse, error) {
+	f.record("GetInsurance", a0)
+	if f.GetInsuranceStub != nil {
+		return f.GetInsuranceStub(a0)
+	}
+	var r0 *bybit.V5GetInsuranceResponse
+	var r1 error
+	f.result("GetInsurance", &r0, &r1)
+	return r0, r1
+}
+
+// GetInsuranceWithContext :
+func (f *V5MarketService) GetInsuranceWithContext(a0 context.Context, a1 bybit.V5GetInsuranceParam) (*bybit.V5GetInsuranceResponse, error) {
+	f.record("GetInsuranceWithContext", a0, a1)
+	if f.GetInsuranceWithContextStub != nil {
+		return f.GetInsuranceWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetInsuranceResponse
+	var r1 error
+	f.result("GetInsuranceWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetKline :
+func (f *V5MarketService) GetKline(a0 bybit.V5GetKlineParam) (*bybit.V5GetKlineResponse, error) {
+	f.record("GetKline", a0)
+	if f.GetKlineStub != nil {
+		return f.GetKlineStub(a0)
+	}
+	var r0 *bybit.V5GetKlineResponse
+	var r1 error
+	f.result("GetKline", &r0, &r1)
+	return r0, r1
+}
+
+// GetKlineWithContext :
+func (f *V5MarketService) GetKlineWithContext(a0 context.Context, a1 bybit.V5GetKlineParam) (*bybit.V5GetKlineResponse, error) {
+	f.record("GetKlineWithContext", a0, a1)
+	if f.GetKlineWithContextStub != nil {
+		return f.GetKlineWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetKlineResponse
+	var r1 error
+	f.result("GetKlineWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetMarkPriceKline :
+func (f *V5MarketService) GetMarkPriceKline(a0 bybit.V5GetMarkPriceKlineParam) (*bybit.V5GetMarkPriceKlineResponse, error) {
+	f.record("GetMarkPriceKline", a0)
+	if f.GetMarkPriceKlineStub != nil {
+		return f.GetMarkPriceKlineStub(a0)
+	}
+	var r0 *bybit.V5GetMarkPriceKlineResponse
+	var r1 error
+	f.result("GetMarkPriceKline", &r0, &r1)
+	return r0, r1
+}
+
+// GetMarkPriceKlineWithContext :
+func (f *V5MarketService) GetMarkPriceKlineWithContext(a0 context.Context, a1 bybit.V5GetMarkPriceKlineParam) (*bybit.V5GetMarkPriceKlineResponse, error) {
+	f.record("GetMarkPriceKlineWithContext", a0, a1)
+	if f.GetMarkPriceKlineWithContextStub != nil {
+		return f.GetMarkPriceKlineWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetMarkPriceKlineResponse
+	var r1 error
+	f.result("GetMarkPriceKlineWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetOpenInterest :
+func (f *V5MarketService) GetOpenInterest(a0 bybit.V5GetOpenInterestParam) (*bybit.V5GetOpenInterestResponse, error) {
+	f.record("GetOpenInterest", a0)
+	if f.GetOpenInterestStub != nil {
+		return f.GetOpenInterestStub(a0)
+	}
+	var r0 *bybit.V5GetOpenInterestResponse
+	var r1 error
+	f.result("GetOpenInterest", &r0, &r1)
+	return r0, r1
+}
+
+// GetOpenInterestWithContext :
+func (f *V5MarketService) GetOpenInterestWithContext(a0 context.Context, a1 bybit.V5GetOpenInterestParam) (*bybit.V5GetOpenInterestResponse, error) {
+	f.record("GetOpenInterestWithContext", a0, a1)
+	if f.GetOpenInterestWithContextStub != nil {
+		return f.GetOpenInterestWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetOpenInterestResponse
+	var r1 error
+	f.result("GetOpenInterestWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetOrderbook :
+func (f *V5MarketService) GetOrderbook(a0 bybit.V5GetOrderbookParam) (*bybit.V5GetOrderbookResponse, error) {
+	f.record("GetOrderbook", a0)
+	if f.GetOrderbookStub != nil {
+		return f.GetOrderbookStub(a0)
+	}
+	var r0 *bybit.V5GetOrderbookResponse
+	var r1 error
+	f.result("GetOrderbook", &r0, &r1)
+	return r0, r1
+}
+
+// GetOrderbookWithContext :
+func (f *V5MarketService) GetOrderbookWithContext(a0 context.Context, a1 bybit.V5GetOrderbookParam) (*bybit.V5GetOrderbookResponse, error) {
+	f.record("GetOrderbookWithContext", a0, a1)
+	if f.GetOrderbookWithContextStub != nil {
+		return f.GetOrderbookWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetOrderbookResponse
+	var r1 error
+	f.result("GetOrderbookWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetPremiumIndexPriceKline :
+func (f *V5MarketService) GetPremiumIndexPriceKline(a0 bybit.V5GetPremiumIndexPriceKlineParam) (*bybit.V5GetPremiumIndexPriceKlineResponse, error) {
+	f.record("GetPremiumIndexPriceKline", a0)
+	if f.GetPremiumIndexPriceKlineStub != nil {
+		return f.GetPremiumIndexPriceKlineStub(a0)
+	}
+	var r0 *bybit.V5GetPremiumIndexPriceKlineResponse
+	var r1 error
+	f.result("GetPremiumIndexPriceKline", &r0, &r1)
+	return r0, r1
+}
+
+// GetPremiumIndexPriceKlineWithContext :
+func (f *V5MarketService) GetPremiumIndexPriceKlineWithContext(a0 context.Context, a1 bybit.V5GetPremiumIndexPriceKlineParam) (*bybit.V5GetPremiumIndexPriceKlineResponse, error) {
+	f.record("GetPremiumIndexPriceKlineWithContext", a0, a1)
+	if f.GetPremiumIndexPriceKlineWithContextStub != nil {
+		return f.GetPremiumIndexPriceKlineWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetPremiumIndexPriceKlineResponse
+	var r1 error
+	f.result("GetPremiumIndexPriceKlineWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetPublicTradingHistory :
+func (f *V5MarketService) GetPublicTradingHistory(a0 bybit.V5GetPublicTradingHistoryParam) (*bybit.V5GetPublicTradingHistoryResponse, error) {
+	f.record("GetPublicTradingHistory", a0)
+	if f.GetPublicTradingHistoryStub != nil {
+		return f.GetPublicTradingHistoryStub(a0)
+	}
+	var r0 *bybit.V5GetPublicTradingHistoryResponse
+	var r1 error
+	f.result("GetPublicTradingHistory", &r0, &r1)
+	return r0, r1
+}
+
+// GetPublicTradingHistoryWithContext :
+func (f *V5MarketService) GetPublicTradingHistoryWithContext(a0 context.Context, a1 bybit.V5GetPublicTradingHistoryParam) (*bybit.V5GetPublicTradingHistoryResponse, error) {
+	f.record("GetPublicTradingHistoryWithContext", a0, a1)
+	if f.GetPublicTradingHistoryWithContextStub != nil {
+		return f.GetPublicTradingHistoryWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetPublicTradingHistoryResponse
+	var r1 error
+	f.result("GetPublicTradingHistoryWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetRiskLimit :
+func (f *V5MarketService) GetRiskLimit(a0 bybit.V5GetRiskLimitParam) (*bybit.V5GetRiskLimitResponse, error) {
+	f.record("GetRiskLimit", a0)
+	if f.GetRiskLimitStub != nil {
+		return f.GetRiskLimitStub(a0)
+	}
+	var r0 *bybit.V5GetRiskLimitResponse
+	var r1 error
+	f.result("GetRiskLimit", &r0, &r1)
+	return r0, r1
+}
+
+// GetRiskLimitWithContext :
+func (f *V5MarketService) GetRiskLimitWithContext(a0 context.Context, a1 bybit.V5GetRiskLimitParam) (*bybit.V5GetRiskLimitResponse, error) {
+	f.record("GetRiskLimitWithContext", a0, a1)
+	if f.GetRiskLimitWithContextStub != nil {
+		return f.GetRiskLimitWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetRiskLimitResponse
+	var r1 error
+	f.result("GetRiskLimitWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetServerTime :
+func (f *V5MarketService) GetServerTime() (*bybit.V5GetServerTimeResponse, error) {
+	f.record("GetServerTime")
+	if f.GetServerTimeStub != nil {
+		return f.GetServerTimeStub()
+	}
+	var r0 *bybit.V5GetServerTimeResponse
+	var r1 error
+	f.result("GetServerTime", &r0, &r1)
+	return r0, r1
+}
+
+// GetServerTimeWithContext :
+func (f *V5MarketService) GetServerTimeWithContext(a0 context.Context) (*bybit.V5GetServerTimeResponse, error) {
+	f.record("GetServerTimeWithContext", a0)
+	if f.GetServerTimeWithContextStub != nil {
+		return f.GetServerTimeWithContextStub(a0)
+	}
+	var r0 *bybit.V5GetServerTimeResponse
+	var r1 error
+	f.result("GetServerTimeWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetTickers :
+func (f *V5MarketService) GetTickers(a0 bybit.V5GetTickersParam) (*bybit.V5GetTickersResponse, error) {
+	f.record("GetTickers", a0)
+	if f.GetTickersStub != nil {
+		return f.GetTickersStub(a0)
+	}
+	var r0 *bybit.V5GetTickersResponse
+	var r1 error
+	f.result("GetTickers", &r0, &r1)
+	return r0, r1
+}
+
+// GetTickersWithContext :
+func (f *V5MarketService) GetTickersWithContext(a0 context.Context, a1 bybit.V5GetTickersParam) (*bybit.V5GetTickersResponse, error) {
+	f.record("GetTickersWithContext", a0, a1)
+	if f.GetTickersWithContextStub != nil {
+		return f.GetTickersWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetTickersResponse
+	var r1 error
+	f.result("GetTickersWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// V5OrderService : fake of bybit.V5OrderServiceI
+type V5OrderService struct {
+	Recorder
+
+	AmendOrderStub                  func(bybit.V5AmendOrderParam) (*bybit.V5AmendOrderResponse, error)
+	AmendOrderWithContextStub       func(context.Context, bybit.V5AmendOrderParam) (*bybit.V5AmendOrderResponse, error)
+	CancelAllOrdersStub             func(bybit.V5CancelAllOrdersParam) (*bybit.V5CancelAllOrdersResponse, error)
+	CancelAllOrdersWithContextStub  func(context.Context, bybit.V5CancelAllOrdersParam) (*bybit.V5CancelAllOrdersResponse, error)
+	CancelOrderStub                 func(bybit.V5CancelOrderParam) (*bybit.V5CancelOrderResponse, error)
+	CancelOrderWithContextStub      func(context.Context, bybit.V5CancelOrderParam) (*bybit.V5CancelOrderResponse, error)
+	CreateOrderStub                 func(bybit.V5CreateOrderParam) (*bybit.V5CreateOrderResponse, error)
+	CreateOrderWithContextStub      func(context.Context, bybit.V5CreateOrderParam) (*bybit.V5CreateOrderResponse, error)
+	GetHistoryOrdersStub            func(bybit.V5GetHistoryOrdersParam) (*bybit.V5GetOrdersResponse, error)
+	GetHistoryOrdersWithContextStub func(context.Context, bybit.V5GetHistoryOrdersParam) (*bybit.V5GetOrdersResponse, error)
+	GetOpenOrdersStub               func(bybit.V5GetOpenOrdersParam) (*bybit.V5GetOrdersResponse, error)
+	GetOpenOrdersWithContextStub    func(context.Context, bybit.V5GetOpenOrdersParam) (*bybit.V5GetOrdersResponse, error)
+}
+
+var _ bybit.V5OrderServiceI = (*V5OrderService)(nil)
+
+// AmendOrder :
+func (f *V5OrderService) AmendOrder(a0 bybit.V5AmendOrderParam) (*bybit.V5AmendOrderResponse, error) {
+	f.record("AmendOrder", a0)
+	if f.AmendOrderStub != nil {
+		return f.AmendOrderStub(a0)
+	}
+	var r0 *bybit.V5AmendOrderResponse
+	var r1 error
+	f.result("AmendOrder", &r0, &r1)
+	return r0, r1
+}
+
+// AmendOrderWithContext :
+func (f *V5OrderService) AmendOrderWithContext(a0 context.Context, a1 bybit.V5AmendOrderParam) (*bybit.V5AmendOrderResponse, error) {
+	f.record("AmendOrderWithContext", a0, a1)
+	if f.AmendOrderWithContextStub != nil {
+		return f.AmendOrderWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5AmendOrderResponse
+	var r1 error
+	f.result("AmendOrderWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// CancelAllOrders :
+func (f *V5OrderService) CancelAllOrders(a0 bybit.V5CancelAllOrdersParam) (*bybit.V5CancelAllOrdersResponse, error) {
+	f.record("CancelAllOrders", a0)
+	if f.CancelAllOrdersStub != nil {
+		return f.CancelAllOrdersStub(a0)
+	}
+	var r0 *bybit.V5CancelAllOrdersResponse
+	var r1 error
+	f.result("CancelAllOrders", &r0, &r1)
+	return r0, r1
+}
+
+// CancelAllOrdersWithContext :
+func (f *V5OrderService) CancelAllOrdersWithContext(a0 context.Context, a1 bybit.V5CancelAllOrdersParam) (*bybit.V5CancelAllOrdersResponse, error) {
+	f.record("CancelAllOrdersWithContext", a0, a1)
+	if f.CancelAllOrdersWithContextStub != nil {
+		return f.CancelAllOrdersWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5CancelAllOrdersResponse
+	var r1 error
+	f.result("CancelAllOrdersWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// CancelOrder :
+func (f *V5OrderService) CancelOrder(a0 bybit.V5CancelOrderParam) (*bybit.V5CancelOrderResponse, error) {
+	f.record("CancelOrder", a0)
+	if f.CancelOrderStub != nil {
+		return f.CancelOrderStub(a0)
+	}
+	var r0 *bybit.V5CancelOrderResponse
+	var r1 error
+	f.result("CancelOrder", &r0, &r1)
+	return r0, r1
+}
+
+// CancelOrderWithContext :
+func (f *V5OrderService) CancelOrderWithContext(a0 context.Context, a1 bybit.V5CancelOrderParam) (*bybit.V5CancelOrderResponse, error) {
+	f.record("CancelOrderWithContext", a0, a1)
+	if f.CancelOrderWithContextStub != nil {
+		return f.CancelOrderWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5CancelOrderResponse
+	var r1 error
+	f.result("CancelOrderWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// CreateOrder :
+func (f *V5OrderService) CreateOrder(a0 bybit.V5CreateOrderParam) (*bybit.V5CreateOrderResponse, error) {
+	f.record("CreateOrder", a0)
+	if f.CreateOrderStub != nil {
+		return f.CreateOrderStub(a0)
+	}
+	var r0 *bybit.V5CreateOrderResponse
+	var r1 error
+	f.result("CreateOrder", &r0, &r1)
+	return r0, r1
+}
+
+// CreateOrderWithContext :
+func (f *V5OrderService) CreateOrderWithContext(a0 context.Context, a1 bybit.V5CreateOrderParam) (*bybit.V5CreateOrderResponse, error) {
+	f.record("CreateOrderWithContext", a0, a1)
+	if f.CreateOrderWithContextStub != nil {
+		return f.CreateOrderWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5CreateOrderResponse
+	var r1 error
+	f.result("CreateOrderWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetHistoryOrders :
+func (f *V5OrderService) GetHistoryOrders(a0 bybit.V5GetHistoryOrdersParam) (*bybit.V5GetOrdersResponse, error) {
+	f.record("GetHistoryOrders", a0)
+	if f.GetHistoryOrdersStub != nil {
+		return f.GetHistoryOrdersStub(a0)
+	}
+	var r0 *bybit.V5GetOrdersResponse
+	var r1 error
+	f.result("GetHistoryOrders", &r0, &r1)
+	return r0, r1
+}
+
+// GetHistoryOrdersWithContext :
+func (f *V5OrderService) GetHistoryOrdersWithContext(a0 context.Context, a1 bybit.V5GetHistoryOrdersParam) (*bybit.V5GetOrdersResponse, error) {
+	f.record("GetHistoryOrdersWithContext", a0, a1)
+	if f.GetHistoryOrdersWithContextStub != nil {
+		return f.GetHistoryOrdersWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetOrdersResponse
+	var r1 error
+	f.result("GetHistoryOrdersWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetOpenOrders :
+func (f *V5OrderService) GetOpenOrders(a0 bybit.V5GetOpenOrdersParam) (*bybit.V5GetOrdersResponse, error) {
+	f.record("GetOpenOrders", a0)
+	if f.GetOpenOrdersStub != nil {
+		return f.GetOpenOrdersStub(a0)
+	}
+	var r0 *bybit.V5GetOrdersResponse
+	var r1 error
+	f.result("GetOpenOrders", &r0, &r1)
+	return r0, r1
+}
+
+// GetOpenOrdersWithContext :
+func (f *V5OrderService) GetOpenOrdersWithContext(a0 context.Context, a1 bybit.V5GetOpenOrdersParam) (*bybit.V5GetOrdersResponse, error) {
+	f.record("GetOpenOrdersWithContext", a0, a1)
+	if f.GetOpenOrdersWithContextStub != nil {
+		return f.GetOpenOrdersWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetOrdersResponse
+	var r1 error
+	f.result("GetOpenOrdersWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// V5PositionService : fake of bybit.V5PositionServiceI
+type V5PositionService struct {
+	Recorder
+
+	GetClosedPnLStub                        func(bybit.V5GetClosedPnLParam) (*bybit.V5GetClosedPnLResponse, error)
+	GetClosedPnLWithContextStub             func(context.Context, bybit.V5GetClosedPnLParam) (*bybit.V5GetClosedPnLResponse, error)
+	GetPositionInfoStub                     func(bybit.V5GetPositionInfoParam) (*bybit.V5GetPositionInfoResponse, error)
+	GetPositionInfoWithContextStub          func(context.Context, bybit.V5GetPositionInfoParam) (*bybit.V5GetPositionInfoResponse, error)
+	SetLeverageStub                         func(bybit.V5SetLeverageParam) (*bybit.V5SetLeverageResponse, error)
+	SetLeverageWithContextStub              func(context.Context, bybit.V5SetLeverageParam) (*bybit.V5SetLeverageResponse, error)
+	SetRiskLimitStub                        func(bybit.V5SetRiskLimitParam) (*bybit.V5SetRiskLimitResponse, error)
+	SetRiskLimitWithContextStub             func(context.Context, bybit.V5SetRiskLimitParam) (*bybit.V5SetRiskLimitResponse, error)
+	SetTpSlModeStub                         func(bybit.V5SetTpSlModeParam) (*bybit.V5SetTpSlModeResponse, error)
+	SetTpSlModeWithContextStub              func(context.Context, bybit.V5SetTpSlModeParam) (*bybit.V5SetTpSlModeResponse, error)
+	SetTradingStopStub                      func(bybit.V5SetTradingStopParam) (*bybit.V5SetTradingStopResponse, error)
+	SetTradingStopWithContextStub           func(context.Context, bybit.V5SetTradingStopParam) (*bybit.V5SetTradingStopResponse, error)
+	SwitchPositionMarginModeStub            func(bybit.V5SwitchPositionMarginModeParam) (*bybit.V5SwitchPositionMarginModeResponse, error)
+	SwitchPositionMarginModeWithContextStub func(context.Context, bybit.V5SwitchPositionMarginModeParam) (*bybit.V5SwitchPositionMarginModeResponse, error)
+	SwitchPositionModeStub                  func(bybit.V5SwitchPositionModeParam) (*bybit.V5SwitchPositionModeResponse, error)
+	SwitchPositionModeWithContextStub       func(context.Context, bybit.V5SwitchPositionModeParam) (*bybit.V5SwitchPositionModeResponse, error)
+}
+
+var _ bybit.V5PositionServiceI = (*V5PositionService)(nil)
+
+// GetClosedPnL :
+func (f *V5PositionService) GetClosedPnL(a0 bybit.V5GetClosedPnLParam) (*bybit.V5GetClosedPnLResponse, error) {
+	f.record("GetClosedPnL", a0)
+	if f.GetClosedPnLStub != nil {
+		return f.GetClosedPnLStub(a0)
+	}
+	var r0 *bybit.V5GetClosedPnLResponse
+	var r1 error
+	f.result("GetClosedPnL", &r0, &r1)
+	return r0, r1
+}
+
+// GetClosedPnLWithContext :
+func (f *V5PositionService) GetClosedPnLWithContext(a0 context.Context, a1 bybit.V5GetClosedPnLParam) (*bybit.V5GetClosedPnLResponse, error) {
+	f.record("GetClosedPnLWithContext", a0, a1)
+	if f.GetClosedPnLWithContextStub != nil {
+		return f.GetClosedPnLWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetClosedPnLResponse
+	var r1 error
+	f.result("GetClosedPnLWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetPositionInfo :
+func (f *V5PositionService) GetPositionInfo(a0 bybit.V5GetPositionInfoParam) (*bybit.V5GetPositionInfoResponse, error) {
+	f.record("GetPositionInfo", a0)
+	if f.GetPositionInfoStub != nil {
+		return f.GetPositionInfoStub(a0)
+	}
+	var r0 *bybit.V5GetPositionInfoResponse
+	var r1 error
+	f.result("GetPositionInfo", &r0, &r1)
+	return r0, r1
+}
+
+// GetPositionInfoWithContext :
+func (f *V5PositionService) GetPositionInfoWithContext(a0 context.Context, a1 bybit.V5GetPositionInfoParam) (*bybit.V5GetPositionInfoResponse, error) {
+	f.record("GetPositionInfoWithContext", a0, a1)
+	if f.GetPositionInfoWithContextStub != nil {
+		return f.GetPositionInfoWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetPositionInfoResponse
+	var r1 error
+	f.result("GetPositionInfoWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// SetLeverage :
+func (f *V5PositionService) SetLeverage(a0 bybit.V5SetLeverageParam) (*bybit.V5SetLeverageResponse, error) {
+	f.record("SetLeverage", a0)
+	if f.SetLeverageStub != nil {
+		return f.SetLeverageStub(a0)
+	}
+	var r0 *bybit.V5SetLeverageResponse
+	var r1 error
+	f.result("SetLeverage", &r0, &r1)
+	return r0, r1
+}
+
+// SetLeverageWithContext :
+func (f *V5PositionService) SetLeverageWithContext(a0 context.Context, a1 bybit.V5SetLeverageParam) (*bybit.V5SetLeverageResponse, error) {
+	f.record("SetLeverageWithContext", a0, a1)
+	if f.SetLeverageWithContextStub != nil {
+		return f.SetLeverageWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5SetLeverageResponse
+	var r1 error
+	f.result("SetLeverageWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// SetRiskLimit :
+func (f *V5PositionService) SetRiskLimit(a0 bybit.V5SetRiskLimitParam) (*bybit.V5SetRiskLimitResponse, error) {
+	f.record("SetRiskLimit", a0)
+	if f.SetRiskLimitStub != nil {
+		return f.SetRiskLimitStub(a0)
+	}
+	var r0 *bybit.V5SetRiskLimitResponse
+	var r1 error
+	f.result("SetRiskLimit", &r0, &r1)
+	return r0, r1
+}
+
+// SetRiskLimitWithContext :
+func (f *V5PositionService) SetRiskLimitWithContext(a0 context.Context, a1 bybit.V5SetRiskLimitParam) (*bybit.V5SetRiskLimitResponse, error) {
+	f.record("SetRiskLimitWithContext", a0, a1)
+	if f.SetRiskLimitWithContextStub != nil {
+		return f.SetRiskLimitWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5SetRiskLimitResponse
+	var r1 error
+	f.result("SetRiskLimitWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// SetTpSlMode :
+func (f *V5PositionService) SetTpSlMode(a0 bybit.V5SetTpSlModeParam) (*bybit.V5SetTpSlModeResponse, error) {
+	f.record("SetTpSlMode", a0)
+	if f.SetTpSlModeStub != nil {
+		return f.SetTpSlModeStub(a0)
+	}
+	var r0 *bybit.V5SetTpSlModeResponse
+	var r1 error
+	f.result("SetTpSlMode", &r0, &r1)
+	return r0, r1
+}
+
+// SetTpSlModeWithContext :
+func (f *V5PositionService) SetTpSlModeWithContext(a0 context.Context, a1 bybit.V5SetTpSlModeParam) (*bybit.V5SetTpSlModeResponse, error) {
+	f.record("SetTpSlModeWithContext", a0, a1)
+	if f.SetTpSlModeWithContextStub != nil {
+		return f.SetTpSlModeWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5SetTpSlModeResponse
+	var r1 error
+	f.result("SetTpSlModeWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// SetTradingStop :
+func (f *V5PositionService) SetTradingStop(a0 bybit.V5SetTradingStopParam) (*bybit.V5SetTradingStopResponse, error) {
+	f.record("SetTradingStop", a0)
+	if f.SetTradingStopStub != nil {
+		return f.SetTradingStopStub(a0)
+	}
+	var r0 *bybit.V5SetTradingStopResponse
+	var r1 error
+	f.result("SetTradingStop", &r0, &r1)
+	return r0, r1
+}
+
+// SetTradingStopWithContext :
+func (f *V5PositionService) SetTradingStopWithContext(a0 context.Context, a1 bybit.V5SetTradingStopParam) (*bybit.V5SetTradingStopResponse, error) {
+	f.record("SetTradingStopWithContext", a0, a1)
+	if f.SetTradingStopWithContextStub != nil {
+		return f.SetTradingStopWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5SetTradingStopResponse
+	var r1 error
+	f.result("SetTradingStopWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// SwitchPositionMarginMode :
+func (f *V5PositionService) SwitchPositionMarginMode(a0 bybit.V5SwitchPositionMarginModeParam) (*bybit.V5SwitchPositionMarginModeResponse, error) {
+	f.record("SwitchPositionMarginMode", a0)
+	if f.SwitchPositionMarginModeStub != nil {
+		return f.SwitchPositionMarginModeStub(a0)
+	}
+	var r0 *bybit.V5SwitchPositionMarginModeResponse
+	var r1 error
+	f.result("SwitchPositionMarginMode", &r0, &r1)
+	return r0, r1
+}
+
+// SwitchPositionMarginModeWithContext :
+func (f *V5PositionService) SwitchPositionMarginModeWithContext(a0 context.Context, a1 bybit.V5SwitchPositionMarginModeParam) (*bybit.V5SwitchPositionMarginModeResponse, error) {
+	f.record("SwitchPositionMarginModeWithContext", a0, a1)
+	if f.SwitchPositionMarginModeWithContextStub != nil {
+		return f.SwitchPositionMarginModeWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5SwitchPositionMarginModeResponse
+	var r1 error
+	f.result("SwitchPositionMarginModeWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// SwitchPositionMode :
+func (f *V5PositionService) SwitchPositionMode(a0 bybit.V5SwitchPositionModeParam) (*bybit.V5SwitchPositionModeResponse, error) {
+	f.record("SwitchPositionMode", a0)
+	if f.SwitchPositionModeStub != nil {
+		return f.SwitchPositionModeStub(a0)
+	}
+	var r0 *bybit.V5SwitchPositionModeResponse
+	var r1 error
+	f.result("SwitchPositionMode", &r0, &r1)
+	return r0, r1
+}
+
+// SwitchPositionModeWithContext :
+func (f *V5PositionService) SwitchPositionModeWithContext(a0 context.Context, a1 bybit.V5SwitchPositionModeParam) (*bybit.V5SwitchPositionModeResponse, error) {
+	f.record("SwitchPositionModeWithContext", a0, a1)
+	if f.SwitchPositionModeWithContextStub != nil {
+		return f.SwitchPositionModeWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5SwitchPositionModeResponse
+	var r1 error
+	f.result("SwitchPositionModeWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// V5ExecutionService : fake of bybit.V5ExecutionServiceI
+type V5ExecutionService struct {
+	Recorder
+
+	GetExecutionListStub            func(bybit.V5GetExecutionParam) (*bybit.V5GetExecutionListResponse, error)
+	GetExecutionListWithContextStub func(context.Context, bybit.V5GetExecutionParam) (*bybit.V5GetExecutionListResponse, error)
+}
+
+var _ bybit.V5ExecutionServiceI = (*V5ExecutionService)(nil)
+
+// GetExecutionList :
+func (f *V5ExecutionService) GetExecutionList(a0 bybit.V5GetExecutionParam) (*bybit.V5GetExecutionListResponse, error) {
+	f.record("GetExecutionList", a0)
+	if f.GetExecutionListStub != nil {
+		return f.GetExecutionListStub(a0)
+	}
+	var r0 *bybit.V5GetExecutionListResponse
+	var r1 error
+	f.result("GetExecutionList", &r0, &r1)
+	return r0, r1
+}
+
+// GetExecutionListWithContext :
+func (f *V5ExecutionService) GetExecutionListWithContext(a0 context.Context, a1 bybit.V5GetExecutionParam) (*bybit.V5GetExecutionListResponse, error) {
+	f.record("GetExecutionListWithContext", a0, a1)
+	if f.GetExecutionListWithContextStub != nil {
+		return f.GetExecutionListWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetExecutionListResponse
+	var r1 error
+	f.result("GetExecutionListWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// V5AccountService : fake of bybit.V5AccountServiceI
+type V5AccountService struct {
+	Recorder
+
+	BatchSetCollateralCoinStub            func(bybit.V5BatchSetCollateralCoinParam) (*bybit.V5BatchSetCollateralCoinResponse, error)
+	BatchSetCollateralCoinWithContextStub func(context.Context, bybit.V5BatchSetCollateralCoinParam) (*bybit.V5BatchSetCollateralCoinResponse, error)
+	GetAccountInfoStub                    func() (*bybit.V5GetAccountInfoResponse, error)
+	GetAccountInfoWithContextStub         func(context.Context) (*bybit.V5GetAccountInfoResponse, error)
+	GetCollateralInfoStub                 func(bybit.V5GetCollateralInfoParam) (*bybit.V5GetCollateralInfoResponse, error)
+	GetCollateralInfoWithContextStub      func(context.Context, bybit.V5GetCollateralInfoParam) (*bybit.V5GetCollateralInfoResponse, error)
+	GetFeeRateStub                        func(bybit.V5GetFeeRateParam) (*bybit.V5GetFeeRateResponse, error)
+	GetFeeRateWithContextStub             func(context.Context, bybit.V5GetFeeRateParam) (*bybit.V5GetFeeRateResponse, error)
+	GetTransactionLogStub                 func(bybit.V5GetTransactionLogParam) (*bybit.V5GetTransactionLogResponse, error)
+	GetTransactionLogWithContextStub      func(context.Context, bybit.V5GetTransactionLogParam) (*bybit.V5GetTransactionLogResponse, error)
+	GetWalletBalanceStub                  func(bybit.AccountTypeV5, []bybit.Coin) (*bybit.V5GetWalletBalanceResponse, error)
+	GetWalletBalanceWithContextStub       func(context.Context, bybit.AccountTypeV5, []bybit.Coin) (*bybit.V5GetWalletBalanceResponse, error)
+	SetCollateralCoinStub                 func(bybit.V5SetCollateralCoinParam) (*bybit.V5SetCollateralCoinResponse, error)
+	SetCollateralCoinWithContextStub      func(context.Context, bybit.V5SetCollateralCoinParam) (*bybit.V5SetCollateralCoinResponse, error)
+}
+
+var _ bybit.V5AccountServiceI = (*V5AccountService)(nil)
+
+// BatchSetCollateralCoin :
+func (f *V5AccountService) BatchSetCollateralCoin(a0 bybit.V5BatchSetCollateralCoinParam) (*bybit.V5BatchSetCollateralCoinResponse, error) {
+	f.record("BatchSetCollateralCoin", a0)
+	if f.BatchSetCollateralCoinStub != nil {
+		return f.BatchSetCollateralCoinStub(a0)
+	}
+	var r0 *bybit.V5BatchSetCollateralCoinResponse
+	var r1 error
+	f.result("BatchSetCollateralCoin", &r0, &r1)
+	return r0, r1
+}
+
+// BatchSetCollateralCoinWithContext :
+func (f *V5AccountService) BatchSetCollateralCoinWithContext(a0 context.Context, a1 bybit.V5BatchSetCollateralCoinParam) (*bybit.V5BatchSetCollateralCoinResponse, error) {
+	f.record("BatchSetCollateralCoinWithContext", a0, a1)
+	if f.BatchSetCollateralCoinWithContextStub != nil {
+		return f.BatchSetCollateralCoinWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5BatchSetCollateralCoinResponse
+	var r1 error
+	f.result("BatchSetCollateralCoinWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetAccountInfo :
+func (f *V5AccountService) GetAccountInfo() (*bybit.V5GetAccountInfoResponse, error) {
+	f.record("GetAccountInfo")
+	if f.GetAccountInfoStub != nil {
+		return f.GetAccountInfoStub()
+	}
+	var r0 *bybit.V5GetAccountInfoResponse
+	var r1 error
+	f.result("GetAccountInfo", &r0, &r1)
+	return r0, r1
+}
+
+// GetAccountInfoWithContext :
+func (f *V5AccountService) GetAccountInfoWithContext(a0 context.Context) (*bybit.V5GetAccountInfoResponse, error) {
+	f.record("GetAccountInfoWithContext", a0)
+	if f.GetAccountInfoWithContextStub != nil {
+		return f.GetAccountInfoWithContextStub(a0)
+	}
+	var r0 *bybit.V5GetAccountInfoResponse
+	var r1 error
+	f.result("GetAccountInfoWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetCollateralInfo :
+func (f *V5AccountService) GetCollateralInfo(a0 bybit.V5GetCollateralInfoParam) (*bybit.V5GetCollateralInfoResponse, error) {
+	f.record("GetCollateralInfo", a0)
+	if f.GetCollateralInfoStub != nil {
+		return f.GetCollateralInfoStub(a0)
+	}
+	var r0 *bybit.V5GetCollateralInfoResponse
+	var r1 error
+	f.result("GetCollateralInfo", &r0, &r1)
+	return r0, r1
+}
+
+// GetCollateralInfoWithContext :
+func (f *V5AccountService) GetCollateralInfoWithContext(a0 context.Context, a1 bybit.V5GetCollateralInfoParam) (*bybit.V5GetCollateralInfoResponse, error) {
+	f.record("GetCollateralInfoWithContext", a0, a1)
+	if f.GetCollateralInfoWithContextStub != nil {
+		return f.GetCollateralInfoWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetCollateralInfoResponse
+	var r1 error
+	f.result("GetCollateralInfoWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetFeeRate :
+func (f *V5AccountService) GetFeeRate(a0 bybit.V5GetFeeRateParam) (*bybit.V5GetFeeRateResponse, error) {
+	f.record("GetFeeRate", a0)
+	if f.GetFeeRateStub != nil {
+		return f.GetFeeRateStub(a0)
+	}
+	var r0 *bybit.V5GetFeeRateResponse
+	var r1 error
+	f.result("GetFeeRate", &r0, &r1)
+	return r0, r1
+}
+
+// GetFeeRateWithContext :
+func (f *V5AccountService) GetFeeRateWithContext(a0 context.Context, a1 bybit.V5GetFeeRateParam) (*bybit.V5GetFeeRateResponse, error) {
+	f.record("GetFeeRateWithContext", a0, a1)
+	if f.GetFeeRateWithContextStub != nil {
+		return f.GetFeeRateWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetFeeRateResponse
+	var r1 error
+	f.result("GetFeeRateWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetTransactionLog :
+func (f *V5AccountService) GetTransactionLog(a0 bybit.V5GetTransactionLogParam) (*bybit.V5GetTransactionLogResponse, error) {
+	f.record("GetTransactionLog", a0)
+	if f.GetTransactionLogStub != nil {
+		return f.GetTransactionLogStub(a0)
+	}
+	var r0 *bybit.V5GetTransactionLogResponse
+	var r1 error
+	f.result("GetTransactionLog", &r0, &r1)
+	return r0, r1
+}
+
+// GetTransactionLogWithContext :
+func (f *V5AccountService) GetTransactionLogWithContext(a0 context.Context, a1 bybit.V5GetTransactionLogParam) (*bybit.V5GetTransactionLogResponse, error) {
+	f.record("GetTransactionLogWithContext", a0, a1)
+	if f.GetTransactionLogWithContextStub != nil {
+		return f.GetTransactionLogWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetTransactionLogResponse
+	var r1 error
+	f.result("GetTransactionLogWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetWalletBalance :
+func (f *V5AccountService) GetWalletBalance(a0 bybit.AccountTypeV5, a1 []bybit.Coin) (*bybit.V5GetWalletBalanceResponse, error) {
+	f.record("GetWalletBalance", a0, a1)
+	if f.GetWalletBalanceStub != nil {
+		return f.GetWalletBalanceStub(a0, a1)
+	}
+	var r0 *bybit.V5GetWalletBalanceResponse
+	var r1 error
+	f.result("GetWalletBalance", &r0, &r1)
+	return r0, r1
+}
+
+// GetWalletBalanceWithContext :
+func (f *V5AccountService) GetWalletBalanceWithContext(a0 context.Context, a1 bybit.AccountTypeV5, a2 []bybit.Coin) (*bybit.V5GetWalletBalanceResponse, error) {
+	f.record("GetWalletBalanceWithContext", a0, a1, a2)
+	if f.GetWalletBalanceWithContextStub != nil {
+		return f.GetWalletBalanceWithContextStub(a0, a1, a2)
+	}
+	var r0 *bybit.V5GetWalletBalanceResponse
+	var r1 error
+	f.result("GetWalletBalanceWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// SetCollateralCoin :
+func (f *V5AccountService) SetCollateralCoin(a0 bybit.V5SetCollateralCoinParam) (*bybit.V5SetCollateralCoinResponse, error) {
+	f.record("SetCollateralCoin", a0)
+	if f.SetCollateralCoinStub != nil {
+		return f.SetCollateralCoinStub(a0)
+	}
+	var r0 *bybit.V5SetCollateralCoinResponse
+	var r1 error
+	f.result("SetCollateralCoin", &r0, &r1)
+	return r0, r1
+}
+
+// SetCollateralCoinWithContext :
+func (f *V5AccountService) SetCollateralCoinWithContext(a0 context.Context, a1 bybit.V5SetCollateralCoinParam) (*bybit.V5SetCollateralCoinResponse, error) {
+	f.record("SetCollateralCoinWithContext", a0, a1)
+	if f.SetCollateralCoinWithContextStub != nil {
+		return f.SetCollateralCoinWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5SetCollateralCoinResponse
+	var r1 error
+	f.result("SetCollateralCoinWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// V5SpotLeverageTokenService : fake of bybit.V5SpotLeverageTokenServiceI
+type V5SpotLeverageTokenService struct {
+	Recorder
+}
+
+var _ bybit.V5SpotLeverageTokenServiceI = (*V5SpotLeverageTokenService)(nil)
+
+// V5SpotMarginTradeService : fake of bybit.V5SpotMarginTradeServiceI
+type V5SpotMarginTradeService struct {
+	Recorder
+}
+
+var _ bybit.V5SpotMarginTradeServiceI = (*V5SpotMarginTradeService)(nil)
+
+// V5AssetService : fake of bybit.V5AssetServiceI
+type V5AssetService struct {
+	Recorder
+
+	CreateInternalTransferStub                 func(bybit.V5CreateInternalTransferParam) (*bybit.V5CreateInternalTransferResponse, error)
+	CreateInternalTransferWithContextStub      func(context.Context, bybit.V5CreateInternalTransferParam) (*bybit.V5CreateInternalTransferResponse, error)
+	CreateUniversalTransferStub                func(bybit.V5CreateUniversalTransferParam) (*bybit.V5CreateUniversalTransferResponse, error)
+	CreateUniversalTransferWithContextStub     func(context.Context, bybit.V5CreateUniversalTransferParam) (*bybit.V5CreateUniversalTransferResponse, error)
+	GetAllCoinsBalanceStub                     func(bybit.V5GetAllCoinsBalanceParam) (*bybit.V5GetAllCoinsBalanceResponse, error)
+	GetAllCoinsBalanceWithContextStub          func(context.Context, bybit.V5GetAllCoinsBalanceParam) (*bybit.V5GetAllCoinsBalanceResponse, error)
+	GetCoinInfoStub                            func(bybit.V5GetCoinInfoParam) (*bybit.V5GetCoinInfoResponse, error)
+	GetCoinInfoWithContextStub                 func(context.Context, bybit.V5GetCoinInfoParam) (*bybit.V5GetCoinInfoResponse, error)
+	GetDepositRecordsStub                      func(bybit.V5GetDepositRecordsParam) (*bybit.V5GetDepositRecordsResponse, error)
+	GetDepositRecordsWithContextStub           func(context.Context, bybit.V5GetDepositRecordsParam) (*bybit.V5GetDepositRecordsResponse, error)
+	GetInternalDepositRecordsStub              func(bybit.V5GetInternalDepositRecordsParam) (*bybit.V5GetInternalDepositRecordsResponse, error)
+	GetInternalDepositRecordsWithContextStub   func(context.Context, bybit.V5GetInternalDepositRecordsParam) (*bybit.V5GetInternalDepositRecordsResponse, error)
+	GetInternalTransferRecordsStub             func(bybit.V5GetInternalTransferRecordsParam) (*bybit.V5GetInternalTransferRecordsResponse, error)
+	GetInternalTransferRecordsWithContextStub  func(context.Context, bybit.V5GetInternalTransferRecordsParam) (*bybit.V5GetInternalTransferRecordsResponse, error)
+	GetMasterDepositAddressStub                func(bybit.V5GetMasterDepositAddressParam) (*bybit.V5GetMasterDepositAddressResponse, error)
+	GetMasterDepositAddressWithContextStub     func(context.Context, bybit.V5GetMasterDepositAddressParam) (*bybit.V5GetMasterDepositAddressResponse, error)
+	GetSubDepositRecordsStub                   func(bybit.V5GetSubDepositRecordsParam) (*bybit.V5GetSubDepositRecordsResponse, error)
+	GetSubDepositRecordsWithContextStub        func(context.Context, bybit.V5GetSubDepositRecordsParam) (*bybit.V5GetSubDepositRecordsResponse, error)
+	GetUniversalTransferRecordsStub            func(bybit.V5GetUniversalTransferRecordsParam) (*bybit.V5GetUniversalTransferRecordsResponse, error)
+	GetUniversalTransferRecordsWithContextStub func(context.Context, bybit.V5GetUniversalTransferRecordsParam) (*bybit.V5GetUniversalTransferRecordsResponse, error)
+	GetWithdrawalRecordsStub                   func(bybit.V5GetWithdrawalRecordsParam) (*bybit.V5GetWithdrawalRecordsResponse, error)
+	GetWithdrawalRecordsWithContextStub        func(context.Context, bybit.V5GetWithdrawalRecordsParam) (*bybit.V5GetWithdrawalRecordsResponse, error)
+	WithdrawStub                               func(bybit.V5WithdrawParam) (*bybit.V5WithdrawResponse, error)
+	WithdrawWithContextStub                    func(context.Context, bybit.V5WithdrawParam) (*bybit.V5WithdrawResponse, error)
+}
+
+var _ bybit.V5AssetServiceI = (*V5AssetService)(nil)
+
+// CreateInternalTransfer :
+func (f *V5AssetService) CreateInternalTransfer(a0 bybit.V5CreateInternalTransferParam) (*bybit.V5CreateInternalTransferResponse, error) {
+	f.record("CreateInternalTransfer", a0)
+	if f.CreateInternalTransferStub != nil {
+		return f.CreateInternalTransferStub(a0)
+	}
+	var r0 *bybit.V5CreateInternalTransferResponse
+	var r1 error
+	f.result("CreateInternalTransfer", &r0, &r1)
+	return r0, r1
+}
+
+// CreateInternalTransferWithContext :
+func (f *V5AssetService) CreateInternalTransferWithContext(a0 context.Context, a1 bybit.V5CreateInternalTransferParam) (*bybit.V5CreateInternalTransferResponse, error) {
+	f.record("CreateInternalTransferWithContext", a0, a1)
+	if f.CreateInternalTransferWithContextStub != nil {
+		return f.CreateInternalTransferWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5CreateInternalTransferResponse
+	var r1 error
+	f.result("CreateInternalTransferWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// CreateUniversalTransfer :
+func (f *V5AssetService) CreateUniversalTransfer(a0 bybit.V5CreateUniversalTransferParam) (*bybit.V5CreateUniversalTransferResponse, error) {
+	f.record("CreateUniversalTransfer", a0)
+	if f.CreateUniversalTransferStub != nil {
+		return f.CreateUniversalTransferStub(a0)
+	}
+	var r0 *bybit.V5CreateUniversalTransferResponse
+	var r1 error
+	f.result("CreateUniversalTransfer", &r0, &r1)
+	return r0, r1
+}
+
+// CreateUniversalTransferWithContext :
+func (f *V5AssetService) CreateUniversalTransferWithContext(a0 context.Context, a1 bybit.V5CreateUniversalTransferParam) (*bybit.V5CreateUniversalTransferResponse, error) {
+	f.record("CreateUniversalTransferWithContext", a0, a1)
+	if f.CreateUniversalTransferWithContextStub != nil {
+		return f.CreateUniversalTransferWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5CreateUniversalTransferResponse
+	var r1 error
+	f.result("CreateUniversalTransferWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetAllCoinsBalance :
+func (f *V5AssetService) GetAllCoinsBalance(a0 bybit.V5GetAllCoinsBalanceParam) (*bybit.V5GetAllCoinsBalanceResponse, error) {
+	f.record("GetAllCoinsBalance", a0)
+	if f.GetAllCoinsBalanceStub != nil {
+		return f.GetAllCoinsBalanceStub(a0)
+	}
+	var r0 *bybit.V5GetAllCoinsBalanceResponse
+	var r1 error
+	f.result("GetAllCoinsBalance", &r0, &r1)
+	return r0, r1
+}
+
+// GetAllCoinsBalanceWithContext :
+func (f *V5AssetService) GetAllCoinsBalanceWithContext(a0 context.Context, a1 bybit.V5GetAllCoinsBalanceParam) (*bybit.V5GetAllCoinsBalanceResponse, error) {
+	f.record("GetAllCoinsBalanceWithContext", a0, a1)
+	if f.GetAllCoinsBalanceWithContextStub != nil {
+		return f.GetAllCoinsBalanceWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetAllCoinsBalanceResponse
+	var r1 error
+	f.result("GetAllCoinsBalanceWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetCoinInfo :
+func (f *V5AssetService) GetCoinInfo(a0 bybit.V5GetCoinInfoParam) (*bybit.V5GetCoinInfoResponse, error) {
+	f.record("GetCoinInfo", a0)
+	if f.GetCoinInfoStub != nil {
+		return f.GetCoinInfoStub(a0)
+	}
+	var r0 *bybit.V5GetCoinInfoResponse
+	var r1 error
+	f.result("GetCoinInfo", &r0, &r1)
+	return r0, r1
+}
+
+// GetCoinInfoWithContext :
+func (f *V5AssetService) GetCoinInfoWithContext(a0 context.Context, a1 bybit.V5GetCoinInfoParam) (*bybit.V5GetCoinInfoResponse, error) {
+	f.record("GetCoinInfoWithContext", a0, a1)
+	if f.GetCoinInfoWithContextStub != nil {
+		return f.GetCoinInfoWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetCoinInfoResponse
+	var r1 error
+	f.result("GetCoinInfoWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetDepositRecords :
+func (f *V5AssetService) GetDepositRecords(a0 bybit.V5GetDepositRecordsParam) (*bybit.V5GetDepositRecordsResponse, error) {
+	f.record("GetDepositRecords", a0)
+	if f.GetDepositRecordsStub != nil {
+		return f.GetDepositRecordsStub(a0)
+	}
+	var r0 *bybit.V5GetDepositRecordsResponse
+	var r1 error
+	f.result("GetDepositRecords", &r0, &r1)
+	return r0, r1
+}
+
+// GetDepositRecordsWithContext :
+func (f *V5AssetService) GetDepositRecordsWithContext(a0 context.Context, a1 bybit.V5GetDepositRecordsParam) (*bybit.V5GetDepositRecordsResponse, error) {
+	f.record("GetDepositRecordsWithContext", a0, a1)
+	if f.GetDepositRecordsWithContextStub != nil {
+		return f.GetDepositRecordsWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetDepositRecordsResponse
+	var r1 error
+	f.result("GetDepositRecordsWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetInternalDepositRecords :
+func (f *V5AssetService) GetInternalDepositRecords(a0 bybit.V5GetInternalDepositRecordsParam) (*bybit.V5GetInternalDepositRecordsResponse, error) {
+	f.record("GetInternalDepositRecords", a0)
+	if f.GetInternalDepositRecordsStub != nil {
+		return f.GetInternalDepositRecordsStub(a0)
+	}
+	var r0 *bybit.V5GetInternalDepositRecordsResponse
+	var r1 error
+	f.result("GetInternalDepositRecords", &r0, &r1)
+	return r0, r1
+}
+
+// GetInternalDepositRecordsWithContext :
+func (f *V5AssetService) GetInternalDepositRecordsWithContext(a0 context.Context, a1 bybit.V5GetInternalDepositRecordsParam) (*bybit.V5GetInternalDepositRecordsResponse, error) {
+	f.record("GetInternalDepositRecordsWithContext", a0, a1)
+	if f.GetInternalDepositRecordsWithContextStub != nil {
+		return f.GetInternalDepositRecordsWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetInternalDepositRecordsResponse
+	var r1 error
+	f.result("GetInternalDepositRecordsWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetInternalTransferRecords :
+func (f *V5AssetService) GetInternalTransferRecords(a0 bybit.V5GetInternalTransferRecordsParam) (*bybit.V5GetInternalTransferRecordsResponse, error) {
+	f.record("GetInternalTransferRecords", a0)
+	if f.GetInternalTransferRecordsStub != nil {
+		return f.GetInternalTransferRecordsStub(a0)
+	}
+	var r0 *bybit.V5GetInternalTransferRecordsResponse
+	var r1 error
+	f.result("GetInternalTransferRecords", &r0, &r1)
+	return r0, r1
+}
+
+// GetInternalTransferRecordsWithContext :
+func (f *V5AssetService) GetInternalTransferRecordsWithContext(a0 context.Context, a1 bybit.V5GetInternalTransferRecordsParam) (*bybit.V5GetInternalTransferRecordsResponse, error) {
+	f.record("GetInternalTransferRecordsWithContext", a0, a1)
+	if f.GetInternalTransferRecordsWithContextStub != nil {
+		return f.GetInternalTransferRecordsWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetInternalTransferRecordsResponse
+	var r1 error
+	f.result("GetInternalTransferRecordsWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetMasterDepositAddress :
+func (f *V5AssetService) GetMasterDepositAddress(a0 bybit.V5GetMasterDepositAddressParam) (*bybit.V5GetMasterDepositAddressResponse, error) {
+	f.record("GetMasterDepositAddress", a0)
+	if f.GetMasterDepositAddressStub != nil {
+		return f.GetMasterDepositAddressStub(a0)
+	}
+	var r0 *bybit.V5GetMasterDepositAddressResponse
+	var r1 error
+	f.result("GetMasterDepositAddress", &r0, &r1)
+	return r0, r1
+}
+
+// GetMasterDepositAddressWithContext :
+func (f *V5AssetService) GetMasterDepositAddressWithContext(a0 context.Context, a1 bybit.V5GetMasterDepositAddressParam) (*bybit.V5GetMasterDepositAddressResponse, error) {
+	f.record("GetMasterDepositAddressWithContext", a0, a1)
+	if f.GetMasterDepositAddressWithContextStub != nil {
+		return f.GetMasterDepositAddressWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetMasterDepositAddressResponse
+	var r1 error
+	f.result("GetMasterDepositAddressWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetSubDepositRecords :
+func (f *V5AssetService) GetSubDepositRecords(a0 bybit.V5GetSubDepositRecordsParam) (*bybit.V5GetSubDepositRecordsResponse, error) {
+	f.record("GetSubDepositRecords", a0)
+	if f.GetSubDepositRecordsStub != nil {
+		return f.GetSubDepositRecordsStub(a0)
+	}
+	var r0 *bybit.V5GetSubDepositRecordsResponse
+	var r1 error
+	f.result("GetSubDepositRecords", &r0, &r1)
+	return r0, r1
+}
+
+// GetSubDepositRecordsWithContext :
+func (f *V5AssetService) GetSubDepositRecordsWithContext(a0 context.Context, a1 bybit.V5GetSubDepositRecordsParam) (*bybit.V5GetSubDepositRecordsResponse, error) {
+	f.record("GetSubDepositRecordsWithContext", a0, a1)
+	if f.GetSubDepositRecordsWithContextStub != nil {
+		return f.GetSubDepositRecordsWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetSubDepositRecordsResponse
+	var r1 error
+	f.result("GetSubDepositRecordsWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetUniversalTransferRecords :
+func (f *V5AssetService) GetUniversalTransferRecords(a0 bybit.V5GetUniversalTransferRecordsParam) (*bybit.V5GetUniversalTransferRecordsResponse, error) {
+	f.record("GetUniversalTransferRecords", a0)
+	if f.GetUniversalTransferRecordsStub != nil {
+		return f.GetUniversalTransferRecordsStub(a0)
+	}
+	var r0 *bybit.V5GetUniversalTransferRecordsResponse
+	var r1 error
+	f.result("GetUniversalTransferRecords", &r0, &r1)
+	return r0, r1
+}
+
+// GetUniversalTransferRecordsWithContext :
+func (f *V5AssetService) GetUniversalTransferRecordsWithContext(a0 context.Context, a1 bybit.V5GetUniversalTransferRecordsParam) (*bybit.V5GetUniversalTransferRecordsResponse, error) {
+	f.record("GetUniversalTransferRecordsWithContext", a0, a1)
+	if f.GetUniversalTransferRecordsWithContextStub != nil {
+		return f.GetUniversalTransferRecordsWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetUniversalTransferRecordsResponse
+	var r1 error
+	f.result("GetUniversalTransferRecordsWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetWithdrawalRecords :
+func (f *V5AssetService) GetWithdrawalRecords(a0 bybit.V5GetWithdrawalRecordsParam) (*bybit.V5GetWithdrawalRecordsResponse, error) {
+	f.record("GetWithdrawalRecords", a0)
+	if f.GetWithdrawalRecordsStub != nil {
+		return f.GetWithdrawalRecordsStub(a0)
+	}
+	var r0 *bybit.V5GetWithdrawalRecordsResponse
+	var r1 error
+	f.result("GetWithdrawalRecords", &r0, &r1)
+	return r0, r1
+}
+
+// GetWithdrawalRecordsWithContext :
+func (f *V5AssetService) GetWithdrawalRecordsWithContext(a0 context.Context, a1 bybit.V5GetWithdrawalRecordsParam) (*bybit.V5GetWithdrawalRecordsResponse, error) {
+	f.record("GetWithdrawalRecordsWithContext", a0, a1)
+	if f.GetWithdrawalRecordsWithContextStub != nil {
+		return f.GetWithdrawalRecordsWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5GetWithdrawalRecordsResponse
+	var r1 error
+	f.result("GetWithdrawalRecordsWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// Withdraw :
+func (f *V5AssetService) Withdraw(a0 bybit.V5WithdrawParam) (*bybit.V5WithdrawResponse, error) {
+	f.record("Withdraw", a0)
+	if f.WithdrawStub != nil {
+		return f.WithdrawStub(a0)
+	}
+	var r0 *bybit.V5WithdrawResponse
+	var r1 error
+	f.result("Withdraw", &r0, &r1)
+	return r0, r1
+}
+
+// WithdrawWithContext :
+func (f *V5AssetService) WithdrawWithContext(a0 context.Context, a1 bybit.V5WithdrawParam) (*bybit.V5WithdrawResponse, error) {
+	f.record("WithdrawWithContext", a0, a1)
+	if f.WithdrawWithContextStub != nil {
+		return f.WithdrawWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5WithdrawResponse
+	var r1 error
+	f.result("WithdrawWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// V5UserService : fake of bybit.V5UserServiceI
+type V5UserService struct {
+	Recorder
+
+	CreateSubUIDStub                  func(bybit.V5CreateSubUIDParam) (*bybit.V5CreateSubUIDResponse, error)
+	CreateSubUIDAPIKeyStub            func(bybit.V5CreateSubUIDAPIKeyParam) (*bybit.V5CreateSubUIDAPIKeyResponse, error)
+	CreateSubUIDAPIKeyWithContextStub func(context.Context, bybit.V5CreateSubUIDAPIKeyParam) (*bybit.V5CreateSubUIDAPIKeyResponse, error)
+	CreateSubUIDWithContextStub       func(context.Context, bybit.V5CreateSubUIDParam) (*bybit.V5CreateSubUIDResponse, error)
+	GetAPIKeyStub                     func() (*bybit.V5APIKeyResponse, error)
+	GetAPIKeyWithContextStub          func(context.Context) (*bybit.V5APIKeyResponse, error)
+	GetSubUIDListStub                 func() (*bybit.V5GetSubUIDListResponse, error)
+	GetSubUIDListWithContextStub      func(context.Context) (*bybit.V5GetSubUIDListResponse, error)
+}
+
+var _ bybit.V5UserServiceI = (*V5UserService)(nil)
+
+// CreateSubUID :
+func (f *V5UserService) CreateSubUID(a0 bybit.V5CreateSubUIDParam) (*bybit.V5CreateSubUIDResponse, error) {
+	f.record("CreateSubUID", a0)
+	if f.CreateSubUIDStub != nil {
+		return f.CreateSubUIDStub(a0)
+	}
+	var r0 *bybit.V5CreateSubUIDResponse
+	var r1 error
+	f.result("CreateSubUID", &r0, &r1)
+	return r0, r1
+}
+
+// CreateSubUIDAPIKey :
+func (f *V5UserService) CreateSubUIDAPIKey(a0 bybit.V5CreateSubUIDAPIKeyParam) (*bybit.V5CreateSubUIDAPIKeyResponse, error) {
+	f.record("CreateSubUIDAPIKey", a0)
+	if f.CreateSubUIDAPIKeyStub != nil {
+		return f.CreateSubUIDAPIKeyStub(a0)
+	}
+	var r0 *bybit.V5CreateSubUIDAPIKeyResponse
+	var r1 error
+	f.result("CreateSubUIDAPIKey", &r0, &r1)
+	return r0, r1
+}
+
+// CreateSubUIDAPIKeyWithContext :
+func (f *V5UserService) CreateSubUIDAPIKeyWithContext(a0 context.Context, a1 bybit.V5CreateSubUIDAPIKeyParam) (*bybit.V5CreateSubUIDAPIKeyResponse, error) {
+	f.record("CreateSubUIDAPIKeyWithContext", a0, a1)
+	if f.CreateSubUIDAPIKeyWithContextStub != nil {
+		return f.CreateSubUIDAPIKeyWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5CreateSubUIDAPIKeyResponse
+	var r1 error
+	f.result("CreateSubUIDAPIKeyWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// CreateSubUIDWithContext :
+func (f *V5UserService) CreateSubUIDWithContext(a0 context.Context, a1 bybit.V5CreateSubUIDParam) (*bybit.V5CreateSubUIDResponse, error) {
+	f.record("CreateSubUIDWithContext", a0, a1)
+	if f.CreateSubUIDWithContextStub != nil {
+		return f.CreateSubUIDWithContextStub(a0, a1)
+	}
+	var r0 *bybit.V5CreateSubUIDResponse
+	var r1 error
+	f.result("CreateSubUIDWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetAPIKey :
+func (f *V5UserService) GetAPIKey() (*bybit.V5APIKeyResponse, error) {
+	f.record("GetAPIKey")
+	if f.GetAPIKeyStub != nil {
+		return f.GetAPIKeyStub()
+	}
+	var r0 *bybit.V5APIKeyResponse
+	var r1 error
+	f.result("GetAPIKey", &r0, &r1)
+	return r0, r1
+}
+
+// GetAPIKeyWithContext :
+func (f *V5UserService) GetAPIKeyWithContext(a0 context.Context) (*bybit.V5APIKeyResponse, error) {
+	f.record("GetAPIKeyWithContext", a0)
+	if f.GetAPIKeyWithContextStub != nil {
+		return f.GetAPIKeyWithContextStub(a0)
+	}
+	var r0 *bybit.V5APIKeyResponse
+	var r1 error
+	f.result("GetAPIKeyWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// GetSubUIDList :
+func (f *V5UserService) GetSubUIDList() (*bybit.V5GetSubUIDListResponse, error) {
+	f.record("GetSubUIDList")
+	if f.GetSubUIDListStub != nil {
+		return f.GetSubUIDListStub()
+	}
+	var r0 *bybit.V5GetSubUIDListResponse
+	var r1 error
+	f.result("GetSubUIDList", &r0, &r1)
+	return r0, r1
+}
+
+// GetSubUIDListWithContext :
+func (f *V5UserService) GetSubUIDListWithContext(a0 context.Context) (*bybit.V5GetSubUIDListResponse, error) {
+	f.record("GetSubUIDListWithContext", a0)
+	if f.GetSubUIDListWithContextStub != nil {
+		return f.GetSubUIDListWithContextStub(a0)
+	}
+	var r0 *bybit.V5GetSubUIDListResponse
+	var r1 error
+	f.result("GetSubUIDListWithContext", &r0, &r1)
+	return r0, r1
+}
+
+// V5WebsocketService : fake of bybit.V5WebsocketServiceI
+type V5WebsocketService struct {
+	Recorder
+
+	PrivateStub func() (bybit.V5WebsocketPrivateServiceI, error)
+	PublicStub  func(bybit.CategoryV5) (bybit.V5WebsocketPublicServiceI, error)
+	TradeStub   func() (bybit.V5WebsocketTradeServiceI, error)
+
+	PrivateService *V5WebsocketPrivateService
+	PublicService  *V5WebsocketPublicService
+	TradeService   *V5WebsocketTradeService
+}
+
+var _ bybit.V5WebsocketServiceI = (*V5WebsocketService)(nil)
+
+// NewV5WebsocketService : a V5WebsocketService handing out new fakes
+func NewV5WebsocketService() *V5WebsocketService {
+	return &V5WebsocketService{
+		PrivateService: &V5WebsocketPrivateService{},
+		PublicService:  &V5WebsocketPublicService{},
+		TradeService:   &V5WebsocketTradeService{},
+	}
+}
+
+// Private :
+func (f *V5WebsocketService) Private() (bybit.V5WebsocketPrivateServiceI, error) {
+	f.record("Private")
+	if f.PrivateStub != nil {
+		return f.PrivateStub()
+	}
+	var r0 bybit.V5WebsocketPrivateServiceI
+	var r1 error
+	if !f.result("Private", &r0, &r1) && f.PrivateService != nil {
+		r0 = f.PrivateService
+	}
+	return r0, r1
+}
+
+// Public :
+func (f *V5WebsocketService) Public(a0 bybit.CategoryV5) (bybit.V5WebsocketPublicServiceI, error) {
+	f.record("Public", a0)
+	if f.PublicStub != nil {
+		return f.PublicStub(a0)
+	}
+	var r0 bybit.V5WebsocketPublicServiceI
+	var r1 error
+	if !f.result("Public", &r0, &r1) && f.PublicService != nil {
+		r0 = f.PublicService
+	}
+	return r0, r1
+}
+
+// Trade :
+func (f *V5WebsocketService) Trade() (bybit.V5WebsocketTradeServiceI, error) {
+	f.record("Trade")
+	if f.TradeStub != nil {
+		return f.TradeStub()
+	}
+	var r0 bybit.V5WebsocketTradeServiceI
+	var r1 error
+	if !f.result("Trade", &r0, &r1) && f.TradeService != nil {
+		r0 = f.TradeService
+	}
+	return r0, r1
+}
+
+// V5WebsocketPublicService : fake of bybit.V5WebsocketPublicServiceI
+type V5WebsocketPublicService struct {
+	Recorder
+
+	CloseStub                   func() error
+	PingStub                    func() error
+	RunStub                     func() error
+	StartStub                   func(context.Context, bybit.ErrHandler) error
+	SubscribeAllLiquidationStub func(bybit.V5WebsocketPublicAllLiquidationParamKey, func(bybit.V5WebsocketPublicAllLiquidationResponse) error) (func() error, error)
+	SubscribeKlineStub          func(bybit.V5WebsocketPublicKlineParamKey, func(bybit.V5WebsocketPublicKlineResponse) error) (func() error, error)
+	SubscribeKlinesStub         func([]bybit.V5WebsocketPublicKlineParamKey, func(bybit.V5WebsocketPublicKlineResponse) error) (func() error, error)
+	SubscribeLiquidationStub    func(bybit.V5WebsocketPublicLiquidationParamKey, func(bybit.V5WebsocketPublicLiquidationResponse) error) (func() error, error)
+	SubscribeOrderBookStub      func(bybit.V5WebsocketPublicOrderBookParamKey, func(bybit.V5WebsocketPublicOrderBookResponse) error) (func() error, error)
+	SubscribeTickerStub         func(bybit.V5WebsocketPublicTickerParamKey, func(bybit.V5WebsocketPublicTickerResponse) error) (func() error, error)
+	SubscribeTickersStub        func([]bybit.V5WebsocketPublicTickerParamKey, func(bybit.V5WebsocketPublicTickerResponse) error) (func() error, error)
+	SubscribeTradeStub          func(bybit.V5WebsocketPublicTradeParamKey, func(bybit.V5WebsocketPublicTradeResponse) error) (func() error, error)
+}
+
+var _ bybit.V5WebsocketPublicServiceI = (*V5WebsocketPublicService)(nil)
+
+// Close :
+func (f *V5WebsocketPublicService) Close() error {
+	f.record("Close")
+	if f.CloseStub != nil {
+		return f.CloseStub()
+	}
+	var r0 error
+	f.result("Close", &r0)
+	return r0
+}
+
+// Ping :
+func (f *V5WebsocketPublicService) Ping() error {
+	f.record("Ping")
+	if f.PingStub != nil {
+		return f.PingStub()
+	}
+	var r0 error
+	f.result("Ping", &r0)
+	return r0
+}
+
+// Run :
+func (f *V5WebsocketPublicService) Run() error {
+	f.record("Run")
+	if f.RunStub != nil {
+		return f.RunStub()
+	}
+	var r0 error
+	f.result("Run", &r0)
+	return r0
+}
+
+// Start :
+func (f *V5WebsocketPublicService) Start(a0 context.Context, a1 bybit.ErrHandler) error {
+	f.record("Start", a0, a1)
+	if f.StartStub != nil {
+		return f.StartStub(a0, a1)
+	}
+	var r0 error
+	f.result("Start", &r0)
+	return r0
+}
+
+// SubscribeAllLiquidation :
+func (f *V5WebsocketPublicService) SubscribeAllLiquidation(a0 bybit.V5WebsocketPublicAllLiquidationParamKey, a1 func(bybit.V5WebsocketPublicAllLiquidationResponse) error) (func() error, error) {
+	f.record("SubscribeAllLiquidation", a0, a1)
+	if f.SubscribeAllLiquidationStub != nil {
+		return f.SubscribeAllLiquidationStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeAllLiquidation", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeKline :
+func (f *V5WebsocketPublicService) SubscribeKline(a0 bybit.V5WebsocketPublicKlineParamKey, a1 func(bybit.V5WebsocketPublicKlineResponse) error) (func() error, error) {
+	f.record("SubscribeKline", a0, a1)
+	if f.SubscribeKlineStub != nil {
+		return f.SubscribeKlineStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeKline", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeKlines :
+func (f *V5WebsocketPublicService) SubscribeKlines(a0 []bybit.V5WebsocketPublicKlineParamKey, a1 func(bybit.V5WebsocketPublicKlineResponse) error) (func() error, error) {
+	f.record("SubscribeKlines", a0, a1)
+	if f.SubscribeKlinesStub != nil {
+		return f.SubscribeKlinesStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeKlines", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeLiquidation :
+func (f *V5WebsocketPublicService) SubscribeLiquidation(a0 bybit.V5WebsocketPublicLiquidationParamKey, a1 func(bybit.V5WebsocketPublicLiquidationResponse) error) (func() error, error) {
+	f.record("SubscribeLiquidation", a0, a1)
+	if f.SubscribeLiquidationStub != nil {
+		return f.SubscribeLiquidationStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeLiquidation", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeOrderBook :
+func (f *V5WebsocketPublicService) SubscribeOrderBook(a0 bybit.V5WebsocketPublicOrderBookParamKey, a1 func(bybit.V5WebsocketPublicOrderBookResponse) error) (func() error, error) {
+	f.record("SubscribeOrderBook", a0, a1)
+	if f.SubscribeOrderBookStub != nil {
+		return f.SubscribeOrderBookStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeOrderBook", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeTicker :
+func (f *V5WebsocketPublicService) SubscribeTicker(a0 bybit.V5WebsocketPublicTickerParamKey, a1 func(bybit.V5WebsocketPublicTickerResponse) error) (func() error, error) {
+	f.record("SubscribeTicker", a0, a1)
+	if f.SubscribeTickerStub != nil {
+		return f.SubscribeTickerStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeTicker", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeTickers :
+func (f *V5WebsocketPublicService) SubscribeTickers(a0 []bybit.V5WebsocketPublicTickerParamKey, a1 func(bybit.V5WebsocketPublicTickerResponse) error) (func() error, error) {
+	f.record("SubscribeTickers", a0, a1)
+	if f.SubscribeTickersStub != nil {
+		return f.SubscribeTickersStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeTickers", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeTrade :
+func (f *V5WebsocketPublicService) SubscribeTrade(a0 bybit.V5WebsocketPublicTradeParamKey, a1 func(bybit.V5WebsocketPublicTradeResponse) error) (func() error, error) {
+	f.record("SubscribeTrade", a0, a1)
+	if f.SubscribeTradeStub != nil {
+		return f.SubscribeTradeStub(a0, a1)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeTrade", &r0, &r1)
+	return r0, r1
+}
+
+// V5WebsocketPrivateService : fake of bybit.V5WebsocketPrivateServiceI
+type V5WebsocketPrivateService struct {
+	Recorder
+
+	CloseStub              func() error
+	PingStub               func() error
+	RunStub                func() error
+	StartStub              func(context.Context, bybit.ErrHandler) error
+	SubscribeStub          func() error
+	SubscribeExecutionStub func(func(bybit.V5WebsocketPrivateExecutionResponse) error) (func() error, error)
+	SubscribeOrderStub     func(func(bybit.V5WebsocketPrivateOrderResponse) error) (func() error, error)
+	SubscribePositionStub  func(func(bybit.V5WebsocketPrivatePositionResponse) error) (func() error, error)
+	SubscribeWalletStub    func(func(bybit.V5WebsocketPrivateWalletResponse) error) (func() error, error)
+}
+
+var _ bybit.V5WebsocketPrivateServiceI = (*V5WebsocketPrivateService)(nil)
+
+// Close :
+func (f *V5WebsocketPrivateService) Close() error {
+	f.record("Close")
+	if f.CloseStub != nil {
+		return f.CloseStub()
+	}
+	var r0 error
+	f.result("Close", &r0)
+	return r0
+}
+
+// Ping :
+func (f *V5WebsocketPrivateService) Ping() error {
+	f.record("Ping")
+	if f.PingStub != nil {
+		return f.PingStub()
+	}
+	var r0 error
+	f.result("Ping", &r0)
+	return r0
+}
+
+// Run :
+func (f *V5WebsocketPrivateService) Run() error {
+	f.record("Run")
+	if f.RunStub != nil {
+		return f.RunStub()
+	}
+	var r0 error
+	f.result("Run", &r0)
+	return r0
+}
+
+// Start :
+func (f *V5WebsocketPrivateService) Start(a0 context.Context, a1 bybit.ErrHandler) error {
+	f.record("Start", a0, a1)
+	if f.StartStub != nil {
+		return f.StartStub(a0, a1)
+	}
+	var r0 error
+	f.result("Start", &r0)
+	return r0
+}
+
+// Subscribe :
+func (f *V5WebsocketPrivateService) Subscribe() error {
+	f.record("Subscribe")
+	if f.SubscribeStub != nil {
+		return f.SubscribeStub()
+	}
+	var r0 error
+	f.result("Subscribe", &r0)
+	return r0
+}
+
+// SubscribeExecution :
+func (f *V5WebsocketPrivateService) SubscribeExecution(a0 func(bybit.V5WebsocketPrivateExecutionResponse) error) (func() error, error) {
+	f.record("SubscribeExecution", a0)
+	if f.SubscribeExecutionStub != nil {
+		return f.SubscribeExecutionStub(a0)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeExecution", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeOrder :
+func (f *V5WebsocketPrivateService) SubscribeOrder(a0 func(bybit.V5WebsocketPrivateOrderResponse) error) (func() error, error) {
+	f.record("SubscribeOrder", a0)
+	if f.SubscribeOrderStub != nil {
+		return f.SubscribeOrderStub(a0)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeOrder", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribePosition :
+func (f *V5WebsocketPrivateService) SubscribePosition(a0 func(bybit.V5WebsocketPrivatePositionResponse) error) (func() error, error) {
+	f.record("SubscribePosition", a0)
+	if f.SubscribePositionStub != nil {
+		return f.SubscribePositionStub(a0)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribePosition", &r0, &r1)
+	return r0, r1
+}
+
+// SubscribeWallet :
+func (f *V5WebsocketPrivateService) SubscribeWallet(a0 func(bybit.V5WebsocketPrivateWalletResponse) error) (func() error, error) {
+	f.record("SubscribeWallet", a0)
+	if f.SubscribeWalletStub != nil {
+		return f.SubscribeWalletStub(a0)
+	}
+	var r0 func() error
+	var r1 error
+	f.result("SubscribeWallet", &r0, &r1)
+	return r0, r1
+}
+
+// V5WebsocketTradeService : fake of bybit.V5WebsocketTradeServiceI
+type V5WebsocketTradeService struct {
+	Recorder
+
+	CancelOrderStub func([]*bybit.V5CancelOrderParam) error
+	CloseStub       func() error
+	CreateOrderStub func([]*bybit.V5CreateOrderParam) error
+	LoginStub       func() error
+	PingStub        func() error
+	RunStub         func() error
+	StartStub       func(context.Context, bybit.ErrHandler) error
+}
+
+var _ bybit.V5WebsocketTradeServiceI = (*V5WebsocketTradeService)(nil)
+
+// CancelOrder :
+func (f *V5WebsocketTradeService) CancelOrder(a0 []*bybit.V5CancelOrderParam) error {
+	f.record("CancelOrder", a0)
+	if f.CancelOrderStub != nil {
+		return f.CancelOrderStub(a0)
+	}
+	var r0 error
+	f.result("CancelOrder", &r0)
+	return r0
+}
+
+// Close :
+func (f *V5WebsocketTradeService) Close() error {
+	f.record("Close")
+	if f.CloseStub != nil {
+		return f.CloseStub()
+	}
+	var r0 error
+	f.result("Close", &r0)
+	return r0
+}
+
+// CreateOrder :
+func (f *V5WebsocketTradeService) CreateOrder(a0 []*bybit.V5CreateOrderParam) error {
+	f.record("CreateOrder", a0)
+	if f.CreateOrderStub != nil {
+		return f.CreateOrderStub(a0)
+	}
+	var r0 error
+	f.result("CreateOrder", &r0)
+	return r0
+}
+
+// Login :
+func (f *V5WebsocketTradeService) Login() error {
+	f.record("Login")
+	if f.LoginStub != nil {
+		return f.LoginStub()
+	}
+	var r0 error
+	f.result("Login", &r0)
+	return r0
+}
+
+// Ping :
+func (f *V5WebsocketTradeService) Ping() error {
+	f.record("Ping")
+	if f.PingStub != nil {
+		return f.PingStub()
+	}
+	var r0 error
+	f.result("Ping", &r0)
+	return r0
+}
+
+// Run :
+func (f *V5WebsocketTradeService) Run() error {
+	f.record("Run")
+	if f.RunStub != nil {
+		return f.RunStub()
+	}
+	var r0 error
+	f.result("Run", &r0)
+	return r0
+}
+
+// Start :
+func (f *V5WebsocketTradeService) Start(a0 context.Context, a1 bybit.ErrHandler) error {
+	f.record("Start", a0, a1)
+	if f.StartStub != nil {
+		return f.StartStub(a0, a1)
+	}
+	var r0 error
+	f.result("Start", &r0)
+	return r0
+}
@@ -0,0 +1,161 @@
+package fakes_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/hirokisan/bybit/v2/fakes"
+	"github.com/hirokisan/bybit/v2/fakes/internal/fakegen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerated(t *testing.T) {
+	t.Run("up to date", func(t *testing.T) {
+		want, err := fakegen.Generate()
+		require.NoError(t, err)
+		got, err := os.ReadFile("fakes_gen.go")
+		require.NoError(t, err)
+		assert.True(t, string(want) == string(got), "fakes_gen.go is stale, run go generate ./fakes")
+	})
+	t.Run("every service interface", func(t *testing.T) {
+		pkgs, err := parser.ParseDir(token.NewFileSet(), "..", func(fi os.FileInfo) bool {
+			return !strings.HasSuffix(fi.Name(), "_test.go")
+		}, 0)
+		require.NoError(t, err)
+
+		generated := map[string]bool{}
+		for _, iface := range fakegen.Interfaces {
+			generated[iface.Name()] = true
+		}
+		for _, file := range pkgs["bybit"].Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				name := spec.Name.Name
+				if _, isInterface := spec.Type.(*ast.InterfaceType); isInterface && strings.HasPrefix(name, "V5") && strings.HasSuffix(name, "ServiceI") {
+					assert.True(t, generated[name], "%s has no fake, add it to fakegen.Interfaces", name)
+				}
+				return true
+			})
+		}
+	})
+}
+
+// placeOrder stands for code under test which only knows the interfaces
+func placeOrder(ctx context.Context, svc bybit.V5ServiceI, qty string) (string, error) {
+	resp, err := svc.Order().CreateOrderWithContext(ctx, bybit.V5CreateOrderParam{
+		Category:  bybit.CategoryV5Linear,
+		Symbol:    bybit.SymbolV5BTCUSDT,
+		Side:      bybit.SideBuy,
+		OrderType: bybit.OrderTypeMarket,
+		Qty:       qty,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create order: %w", err)
+	}
+	return resp.Result.OrderID, nil
+}
+
+func TestV5Service(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("zero values", func(t *testing.T) {
+		svc := fakes.NewV5Service()
+		resp, err := svc.Market().GetServerTime()
+		assert.NoError(t, err)
+		assert.Nil(t, resp)
+		fakes.AssertCallCount(t, svc.MarketService, "GetServerTime", 1)
+		fakes.AssertCallCount(t, svc, "Market", 1)
+	})
+	t.Run("returns", func(t *testing.T) {
+		svc := fakes.NewV5Service()
+		svc.OrderService.Returns("CreateOrderWithContext", &bybit.V5CreateOrderResponse{
+			Result: bybit.V5CreateOrderResult{OrderID: "1"},
+		}, nil)
+
+		orderID, err := placeOrder(ctx, svc, "0.01")
+		require.NoError(t, err)
+		assert.Equal(t, "1", orderID)
+
+		fakes.AssertCalled(t, svc.OrderService, "CreateOrderWithContext", fakes.Any, bybit.V5CreateOrderParam{
+			Category:  bybit.CategoryV5Linear,
+			Symbol:    bybit.SymbolV5BTCUSDT,
+			Side:      bybit.SideBuy,
+			OrderType: bybit.OrderTypeMarket,
+			Qty:       "0.01",
+		})
+		fakes.AssertNotCalled(t, svc.OrderService, "CancelOrder")
+
+		svc.OrderService.Returns("CreateOrderWithContext", nil, errors.New("insufficient balance"))
+		_, err = placeOrder(ctx, svc, "100")
+		assert.EqualError(t, err, "create order: insufficient balance")
+		assert.Len(t, svc.OrderService.CallsTo("CreateOrderWithContext"), 2)
+	})
+	t.Run("stub", func(t *testing.T) {
+		svc := fakes.NewV5Service()
+		svc.OrderService.CreateOrderWithContextStub = func(_ context.Context, param bybit.V5CreateOrderParam) (*bybit.V5CreateOrderResponse, error) {
+			return &bybit.V5CreateOrderResponse{Result: bybit.V5CreateOrderResult{OrderID: "qty-" + param.Qty}}, nil
+		}
+		orderID, err := placeOrder(ctx, svc, "0.5")
+		require.NoError(t, err)
+		assert.Equal(t, "qty-0.5", orderID)
+	})
+	t.Run("failing assertions", func(t *testing.T) {
+		svc := fakes.NewV5Service()
+		svc.OrderService.Returns("CreateOrderWithContext", &bybit.V5CreateOrderResponse{}, nil)
+		_, _ = placeOrder(ctx, svc, "1")
+
+		rec := &recordingT{}
+		assert.False(t, fakes.AssertCalled(rec, svc.OrderService, "CreateOrderWithContext", fakes.Any, bybit.V5CreateOrderParam{Qty: "2"}))
+		assert.False(t, fakes.AssertCallCount(rec, svc.OrderService, "CreateOrderWithContext", 2))
+		assert.False(t, fakes.AssertNotCalled(rec, svc.OrderService, "CreateOrderWithContext"))
+		require.Len(t, rec.errors, 3)
+		assert.Contains(t, rec.errors[0], "CreateOrderWithContext not called with (Any, {Category: Symbol: Side: OrderType: Qty:2")
+		assert.Contains(t, rec.errors[0], "Qty:1")
+	})
+	t.Run("wrong return type", func(t *testing.T) {
+		svc := fakes.NewV5Service()
+		svc.MarketService.Returns("GetServerTime", "not a response", nil)
+		assert.PanicsWithValue(t, "fakes: GetServerTime result 0 is *bybit.V5GetServerTimeResponse, configured string", func() {
+			_, _ = svc.Market().GetServerTime()
+		})
+	})
+}
+
+func TestV5WebsocketService(t *testing.T) {
+	ws := &fakes.V5WebsocketService{PrivateService: &fakes.V5WebsocketPrivateService{}}
+	var svc bybit.V5WebsocketServiceI = ws
+
+	private, err := svc.Private()
+	require.NoError(t, err)
+	require.NoError(t, private.Subscribe())
+	_, err = private.SubscribeOrder(func(bybit.V5WebsocketPrivateOrderResponse) error { return nil })
+	require.NoError(t, err)
+	fakes.AssertCallCount(t, ws.PrivateService, "SubscribeOrder", 1)
+
+	ws.Returns("Public", nil, errors.New("dial"))
+	_, err = svc.Public(bybit.CategoryV5Spot)
+	assert.EqualError(t, err, "dial")
+	fakes.AssertCalled(t, ws, "Public", bybit.CategoryV5Spot)
+}
+
+type recordingT struct {
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
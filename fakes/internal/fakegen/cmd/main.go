@@ -0,0 +1,22 @@
+// Command fakegen writes the fakes package source to the file given as argument.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/hirokisan/bybit/v2/fakes/internal/fakegen"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: fakegen <output file>")
+	}
+	src, err := fakegen.Generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(os.Args[1], src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
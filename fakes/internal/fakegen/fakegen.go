@@ -0,0 +1,275 @@
+// Package fakegen generates the fakes package from the V5 service interfaces.
+package fakegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hirokisan/bybit/v2"
+)
+
+// Interfaces : the interfaces which get a fake, the fake is named after the interface without the I suffix
+var Interfaces = []reflect.Type{
+	reflect.TypeOf((*bybit.V5ServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5MarketServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5OrderServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5PositionServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5ExecutionServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5AccountServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5SpotLeverageTokenServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5SpotMarginTradeServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5AssetServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5UserServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5WebsocketServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5WebsocketPublicServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5WebsocketPrivateServiceI)(nil)).Elem(),
+	reflect.TypeOf((*bybit.V5WebsocketTradeServiceI)(nil)).Elem(),
+}
+
+const bybitPkgPath = "github.com/hirokisan/bybit/v2"
+
+// FakeName : the name of the fake of an interface
+func FakeName(iface reflect.Type) string {
+	return strings.TrimSuffix(iface.Name(), "I")
+}
+
+type generator struct {
+	buf     bytes.Buffer
+	imports map[string]bool
+	fakes   map[reflect.Type]string
+}
+
+// Generate : the gofmt-ed source of the fakes
+func Generate() ([]byte, error) {
+	g := &generator{
+		imports: map[string]bool{},
+		fakes:   map[reflect.Type]string{},
+	}
+	for _, iface := range Interfaces {
+		g.fakes[iface] = FakeName(iface)
+	}
+
+	var body bytes.Buffer
+	for _, iface := range Interfaces {
+		g.buf.Reset()
+		g.generate(iface)
+		body.Write(g.buf.Bytes())
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by fakegen. DO NOT EDIT.\n\npackage fakes\n\nimport (\n")
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	// standard library first, like goimports
+	sort.Slice(paths, func(i, j int) bool {
+		si, sj := isStdlib(paths[i]), isStdlib(paths[j])
+		if si != sj {
+			return si
+		}
+		return paths[i] < paths[j]
+	})
+	for i, path := range paths {
+		if i > 0 && isStdlib(paths[i-1]) && !isStdlib(path) {
+			out.WriteString("\n")
+		}
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	out.WriteString(")\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format: %w\n%s", err, out.Bytes())
+	}
+	return src, nil
+}
+
+func isStdlib(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) generate(iface reflect.Type) {
+	name := g.fakes[iface]
+	g.imports[bybitPkgPath] = true
+
+	g.printf("\n// %s : fake of bybit.%s\n", name, iface.Name())
+	g.printf("type %s struct {\n\tRecorder\n\n", name)
+	for i := 0; i < iface.NumMethod(); i++ {
+		m := iface.Method(i)
+		g.printf("\t%sStub %s\n", m.Name, g.funcType(m.Type))
+	}
+	if accessors := g.accessors(iface); len(accessors) > 0 {
+		g.printf("\n")
+		for _, m := range accessors {
+			g.printf("\t%s *%s\n", accessorField(m), g.fakes[m.Type.Out(0)])
+		}
+	}
+	g.printf("}\n\nvar _ bybit.%s = (*%s)(nil)\n", iface.Name(), name)
+
+	if accessors := g.accessors(iface); len(accessors) > 0 {
+		g.printf("\n// New%s : a %s handing out new fakes\nfunc New%s() *%s {\n\treturn &%s{\n", name, name, name, name, name)
+		for _, m := range accessors {
+			g.printf("\t\t%s: &%s{},\n", accessorField(m), g.fakes[m.Type.Out(0)])
+		}
+		g.printf("\t}\n}\n")
+	}
+
+	for i := 0; i < iface.NumMethod(); i++ {
+		g.method(name, iface.Method(i))
+	}
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// isAccessor reports whether m hands out another faked interface, like V5ServiceI.Order or V5WebsocketServiceI.Public
+func (g *generator) isAccessor(m reflect.Method) bool {
+	t := m.Type
+	if t.NumOut() == 0 || t.NumOut() > 2 || (t.NumOut() == 2 && t.Out(1) != errorType) {
+		return false
+	}
+	_, ok := g.fakes[t.Out(0)]
+	return ok
+}
+
+func (g *generator) accessors(iface reflect.Type) []reflect.Method {
+	var result []reflect.Method
+	for i := 0; i < iface.NumMethod(); i++ {
+		if m := iface.Method(i); g.isAccessor(m) {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func accessorField(m reflect.Method) string {
+	return m.Name + "Service"
+}
+
+func (g *generator) method(name string, m reflect.Method) {
+	t := m.Type
+	params := make([]string, t.NumIn())
+	args := make([]string, t.NumIn())
+	for i := 0; i < t.NumIn(); i++ {
+		args[i] = fmt.Sprintf("a%d", i)
+		if t.IsVariadic() && i == t.NumIn()-1 {
+			params[i] = fmt.Sprintf("a%d ...%s", i, g.typeString(t.In(i).Elem()))
+			continue
+		}
+		params[i] = fmt.Sprintf("a%d %s", i, g.typeString(t.In(i)))
+	}
+	callArgs := strings.Join(args, ", ")
+	if t.IsVariadic() {
+		callArgs += "..."
+	}
+	results := make([]string, t.NumOut())
+	refs := make([]string, t.NumOut())
+	for i := 0; i < t.NumOut(); i++ {
+		results[i] = fmt.Sprintf("r%d", i)
+		refs[i] = "&" + results[i]
+	}
+
+	g.printf("\n// %s :\nfunc (f *%s) %s(%s) %s {\n", m.Name, name, m.Name, strings.Join(params, ", "), g.resultList(t))
+	g.printf("\tf.record(%q", m.Name)
+	for _, arg := range args {
+		g.printf(", %s", arg)
+	}
+	g.printf(")\n")
+
+	ret := "return "
+	if t.NumOut() == 0 {
+		ret = ""
+	}
+	g.printf("\tif f.%sStub != nil {\n\t\t%sf.%sStub(%s)\n", m.Name, ret, m.Name, callArgs)
+	if t.NumOut() == 0 {
+		g.printf("\t\treturn\n")
+	}
+	g.printf("\t}\n")
+	if t.NumOut() == 0 {
+		g.printf("}\n")
+		return
+	}
+	for i := 0; i < t.NumOut(); i++ {
+		g.printf("\tvar r%d %s\n", i, g.typeString(t.Out(i)))
+	}
+	if g.isAccessor(m) {
+		g.printf("\tif !f.result(%q, %s) && f.%s != nil {\n\t\tr0 = f.%s\n\t}\n", m.Name, strings.Join(refs, ", "), accessorField(m), accessorField(m))
+		g.printf("\treturn %s\n}\n", strings.Join(results, ", "))
+		return
+	}
+	g.printf("\tf.result(%q, %s)\n", m.Name, strings.Join(refs, ", "))
+	g.printf("\treturn %s\n}\n", strings.Join(results, ", "))
+}
+
+func (g *generator) resultList(t reflect.Type) string {
+	switch t.NumOut() {
+	case 0:
+		return ""
+	case 1:
+		return g.typeString(t.Out(0))
+	}
+	outs := make([]string, t.NumOut())
+	for i := range outs {
+		outs[i] = g.typeString(t.Out(i))
+	}
+	return "(" + strings.Join(outs, ", ") + ")"
+}
+
+func (g *generator) funcType(t reflect.Type) string {
+	ins := make([]string, t.NumIn())
+	for i := range ins {
+		if t.IsVariadic() && i == t.NumIn()-1 {
+			ins[i] = "..." + g.typeString(t.In(i).Elem())
+			continue
+		}
+		ins[i] = g.typeString(t.In(i))
+	}
+	result := g.resultList(t)
+	if result != "" {
+		result = " " + result
+	}
+	return "func(" + strings.Join(ins, ", ") + ")" + result
+}
+
+// typeString renders t as seen from package fakes, collecting imports
+func (g *generator) typeString(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+		g.imports[t.PkgPath()] = true
+		pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+		if t.PkgPath() == bybitPkgPath {
+			pkg = "bybit"
+		}
+		return pkg + "." + t.Name()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + g.typeString(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeString(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), g.typeString(t.Elem()))
+	case reflect.Map:
+		return "map[" + g.typeString(t.Key()) + "]" + g.typeString(t.Elem())
+	case reflect.Chan:
+		return t.ChanDir().String() + " " + g.typeString(t.Elem())
+	case reflect.Func:
+		return g.funcType(t)
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "interface{}"
+		}
+	}
+	panic(fmt.Sprintf("fakegen: unsupported type %s", t))
+}
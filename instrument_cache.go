@@ -0,0 +1,446 @@
+package bybit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rounding : the direction RoundPrice and RoundQty round to
+type Rounding int
+
+const (
+	// RoundingFloor : towards negative infinity, the default
+	RoundingFloor Rounding = iota
+	// RoundingCeil : towards positive infinity
+	RoundingCeil
+)
+
+// Instrument : the trading rules of a symbol, the same fields for every category
+// Limits Bybit does not have for the category are empty.
+type Instrument struct {
+	Category CategoryV5
+	Symbol   SymbolV5
+	Status   InstrumentStatus
+
+	TickSize string
+	MinPrice string
+	MaxPrice string
+
+	QtyStep        string
+	MinOrderQty    string
+	MaxOrderQty    string
+	MaxMktOrderQty string
+
+	// MinNotionalValue is minNotionalValue of linear and minOrderAmt of spot
+	MinNotionalValue string
+	// MaxNotionalValue is maxOrderAmt of spot
+	MaxNotionalValue string
+	// QuoteStep is quotePrecision of spot, the step of market buy orders in quote coin
+	QuoteStep string
+}
+
+// RoundPrice : rounds price to a multiple of TickSize
+func (i Instrument) RoundPrice(price string, rounding Rounding) (string, error) {
+	return roundToStep(price, i.TickSize, rounding)
+}
+
+// RoundQty : rounds qty to a multiple of QtyStep
+func (i Instrument) RoundQty(qty string, rounding Rounding) (string, error) {
+	return roundToStep(qty, i.QtyStep, rounding)
+}
+
+// ValidateOrder : checks the qty and prices of param against the instrument
+// Errors match ErrInvalidPrecision or ErrOrderLimit with errors.Is, like the rejections of Bybit.
+// Market orders have no price to know their value, so their notional value is not checked,
+// use ValidateOrderAt with the last or mark price for that.
+func (i Instrument) ValidateOrder(param V5CreateOrderParam) error {
+	return i.ValidateOrderAt(param, "")
+}
+
+// ValidateOrderAt : ValidateOrder, checking the notional value of market orders at referencePrice
+// e.g. the last or mark price of the ticker. An empty referencePrice skips the check as ValidateOrder does.
+func (i Instrument) ValidateOrderAt(param V5CreateOrderParam, referencePrice string) error {
+	switch i.Status {
+	case "", InstrumentStatusTrading, InstrumentStatusOnline:
+	default:
+		return fmt.Errorf("%s is %s", i.Symbol, i.Status)
+	}
+
+	qty, err := parseDecimal("qty", param.Qty)
+	if err != nil {
+		return err
+	}
+	if qty.Sign() <= 0 {
+		return fmt.Errorf("%w: qty %s must be positive", ErrOrderLimit, param.Qty)
+	}
+
+	// spot market buys are sized in the quote coin unless marketUnit says otherwise
+	quoteQty := i.Category == CategoryV5Spot && param.OrderType == OrderTypeMarket && param.Side == SideBuy &&
+		(param.MarketUnit == nil || *param.MarketUnit == MarketUnitQuoteCoin)
+	if quoteQty {
+		if err := checkStep("qty", param.Qty, qty, i.QuoteStep); err != nil {
+			return err
+		}
+		return checkRange("order value", param.Qty, qty, i.MinNotionalValue, i.MaxNotionalValue)
+	}
+
+	if err := checkStep("qty", param.Qty, qty, i.QtyStep); err != nil {
+		return err
+	}
+	maxQty := i.MaxOrderQty
+	if param.OrderType == OrderTypeMarket && i.MaxMktOrderQty != "" {
+		maxQty = i.MaxMktOrderQty
+	}
+	if err := checkRange("qty", param.Qty, qty, i.MinOrderQty, maxQty); err != nil {
+		return err
+	}
+
+	for _, p := range []struct {
+		name  string
+		value *string
+	}{
+		{"price", param.Price},
+		{"trigger price", param.TriggerPrice},
+	} {
+		if p.value == nil || *p.value == "" {
+			continue
+		}
+		price, err := parseDecimal(p.name, *p.value)
+		if err != nil {
+			return err
+		}
+		if err := checkStep(p.name, *p.value, price, i.TickSize); err != nil {
+			return err
+		}
+		if err := checkRange(p.name, *p.value, price, i.MinPrice, i.MaxPrice); err != nil {
+			return err
+		}
+	}
+
+	// inverse contracts are sized in the quote coin already, other orders need a price to know their value
+	if i.Category == CategoryV5Inverse {
+		return nil
+	}
+	rawPrice := referencePrice
+	if param.OrderType == OrderTypeLimit && param.Price != nil {
+		rawPrice = *param.Price
+	}
+	if rawPrice == "" {
+		return nil
+	}
+	price, err := parseDecimal("reference price", rawPrice)
+	if err != nil {
+		return err
+	}
+	value := new(big.Rat).Mul(price, qty)
+	return checkRange("order value", value.FloatString(decimalPlaces(rawPrice)+decimalPlaces(param.Qty)), value, i.MinNotionalValue, i.MaxNotionalValue)
+}
+
+// InstrumentCache : the instruments of spot, linear and inverse, loaded with GetInstrumentsInfo
+// Bybit lists options per base coin, WithOptionBaseCoins adds the options of the given ones.
+// An InstrumentCache is safe for concurrent use.
+type InstrumentCache struct {
+	market          V5MarketServiceI
+	categories      []CategoryV5
+	optionBaseCoins []Coin
+
+	mu          sync.RWMutex
+	instruments map[CategoryV5]map[SymbolV5]Instrument
+	loadedAt    time.Time
+}
+
+// NewInstrumentCache : covers spot, linear and inverse until WithCategories or WithOptionBaseCoins says otherwise
+func NewInstrumentCache(market V5MarketServiceI) *InstrumentCache {
+	return &InstrumentCache{
+		market:      market,
+		categories:  []CategoryV5{CategoryV5Spot, CategoryV5Linear, CategoryV5Inverse},
+		instruments: map[CategoryV5]map[SymbolV5]Instrument{},
+	}
+}
+
+// WithCategories :
+func (c *InstrumentCache) WithCategories(categories ...CategoryV5) *InstrumentCache {
+	c.categories = categories
+
+	return c
+}
+
+// WithOptionBaseCoins : loads the options of each base coin, adding option to the categories
+func (c *InstrumentCache) WithOptionBaseCoins(coins ...Coin) *InstrumentCache {
+	c.optionBaseCoins = coins
+	if !slices.Contains(c.categories, CategoryV5Option) {
+		c.categories = append(slices.Clip(c.categories), CategoryV5Option)
+	}
+
+	return c
+}
+
+// Refresh : loads every instrument of the categories, following the cursor
+// The cache is replaced only when every category loaded.
+func (c *InstrumentCache) Refresh(ctx context.Context) error {
+	instruments := map[CategoryV5]map[SymbolV5]Instrument{}
+	for _, category := range c.categories {
+		loaded, err := c.load(ctx, category)
+		if err != nil {
+			return fmt.Errorf("instruments of %s: %w", category, err)
+		}
+		instruments[category] = loaded
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.instruments = instruments
+	c.loadedAt = time.Now()
+	return nil
+}
+
+// StartRefresh refreshes once and then keeps refreshing on interval until ctx is done.
+// Errors are passed to errHandler when given, the cache keeps the instruments of the last successful refresh.
+func (c *InstrumentCache) StartRefresh(ctx context.Context, interval time.Duration, errHandler func(error)) error {
+	if interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if err := c.Refresh(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.Refresh(ctx); err != nil && errHandler != nil && ctx.Err() == nil {
+					errHandler(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// LoadedAt : when the last successful refresh finished, zero before the first one
+func (c *InstrumentCache) LoadedAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.loadedAt
+}
+
+// Instrument :
+func (c *InstrumentCache) Instrument(category CategoryV5, symbol SymbolV5) (Instrument, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	instrument, ok := c.instruments[category][symbol]
+	return instrument, ok
+}
+
+// Instruments : the instruments of the category
+func (c *InstrumentCache) Instruments(category CategoryV5) []Instrument {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	instruments := make([]Instrument, 0, len(c.instruments[category]))
+	for _, instrument := range c.instruments[category] {
+		instruments = append(instruments, instrument)
+	}
+	return instruments
+}
+
+// RoundPrice : rounds price to the tick size of the symbol
+func (c *InstrumentCache) RoundPrice(category CategoryV5, symbol SymbolV5, price string, rounding Rounding) (string, error) {
+	instrument, err := c.lookup(category, symbol)
+	if err != nil {
+		return "", err
+	}
+	return instrument.RoundPrice(price, rounding)
+}
+
+// RoundQty : rounds qty to the qty step of the symbol
+func (c *InstrumentCache) RoundQty(category CategoryV5, symbol SymbolV5, qty string, rounding Rounding) (string, error) {
+	instrument, err := c.lookup(category, symbol)
+	if err != nil {
+		return "", err
+	}
+	return instrument.RoundQty(qty, rounding)
+}
+
+// ValidateOrder : checks param against the instrument of its category and symbol before sending it
+// The notional value of market orders is not checked, see ValidateOrderAt.
+func (c *InstrumentCache) ValidateOrder(param V5CreateOrderParam) error {
+	return c.ValidateOrderAt(param, "")
+}
+
+// ValidateOrderAt : ValidateOrder, checking the notional value of market orders at referencePrice
+func (c *InstrumentCache) ValidateOrderAt(param V5CreateOrderParam, referencePrice string) error {
+	instrument, err := c.lookup(param.Category, param.Symbol)
+	if err != nil {
+		return err
+	}
+	return instrument.ValidateOrderAt(param, referencePrice)
+}
+
+func (c *InstrumentCache) lookup(category CategoryV5, symbol SymbolV5) (Instrument, error) {
+	instrument, ok := c.Instrument(category, symbol)
+	if !ok {
+		return Instrument{}, fmt.Errorf("instrument %s %s is not in the cache", category, symbol)
+	}
+	return instrument, nil
+}
+
+func (c *InstrumentCache) load(ctx context.Context, category CategoryV5) (map[SymbolV5]Instrument, error) {
+	instruments := map[SymbolV5]Instrument{}
+	param := V5GetInstrumentsInfoParam{Category: category}
+	page := WithPageSizeOption(1000)
+
+	switch category {
+	case CategoryV5Linear, CategoryV5Inverse:
+		for item, err := range IterLinearInverseInstruments(ctx, c.market, param, page) {
+			if err != nil {
+				return nil, err
+			}
+			instruments[item.Symbol] = Instrument{
+				Category:         category,
+				Symbol:           item.Symbol,
+				Status:           item.Status,
+				TickSize:         item.PriceFilter.TickSize,
+				MinPrice:         item.PriceFilter.MinPrice,
+				MaxPrice:         item.PriceFilter.MaxPrice,
+				QtyStep:          item.LotSizeFilter.QtyStep,
+				MinOrderQty:      item.LotSizeFilter.MinOrderQty,
+				MaxOrderQty:      item.LotSizeFilter.MaxOrderQty,
+				MaxMktOrderQty:   item.LotSizeFilter.MaxMktOrderQty,
+				MinNotionalValue: item.LotSizeFilter.MinNotionalValue,
+			}
+		}
+	case CategoryV5Option:
+		// without a base coin Bybit only lists the options of BTC
+		if len(c.optionBaseCoins) == 0 {
+			return nil, errors.New("options are listed per base coin, set them with WithOptionBaseCoins")
+		}
+		for _, coin := range c.optionBaseCoins {
+			param := V5GetInstrumentsInfoParam{Category: category, BaseCoin: &coin}
+			for item, err := range IterOptionInstruments(ctx, c.market, param, page) {
+				if err != nil {
+					return nil, err
+				}
+				instruments[item.Symbol] = Instrument{
+					Category:    category,
+					Symbol:      item.Symbol,
+					Status:      item.Status,
+					TickSize:    item.PriceFilter.TickSize,
+					MinPrice:    item.PriceFilter.MinPrice,
+					MaxPrice:    item.PriceFilter.MaxPrice,
+					QtyStep:     item.LotSizeFilter.QtyStep,
+					MinOrderQty: item.LotSizeFilter.MinOrderQty,
+					MaxOrderQty: item.LotSizeFilter.MaxOrderQty,
+				}
+			}
+		}
+	case CategoryV5Spot:
+		for item, err := range IterSpotInstruments(ctx, c.market, param, page) {
+			if err != nil {
+				return nil, err
+			}
+			instruments[item.Symbol] = Instrument{
+				Category:         category,
+				Symbol:           item.Symbol,
+				Status:           item.Status,
+				TickSize:         item.PriceFilter.TickSize,
+				QtyStep:          item.LotSizeFilter.BasePrecision,
+				MinOrderQty:      item.LotSizeFilter.MinOrderQty,
+				MaxOrderQty:      item.LotSizeFilter.MaxOrderQty,
+				MinNotionalValue: item.LotSizeFilter.MinOrderAmt,
+				MaxNotionalValue: item.LotSizeFilter.MaxOrderAmt,
+				QuoteStep:        item.LotSizeFilter.QuotePrecision,
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown category %q", category)
+	}
+	return instruments, nil
+}
+
+func parseDecimal(name, s string) (*big.Rat, error) {
+	if !isDecimal(normalizeDecimal(s)) {
+		return nil, fmt.Errorf("%s %q is not a decimal", name, s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%s %q is not a decimal", name, s)
+	}
+	return r, nil
+}
+
+// decimalPlaces counts the significant decimals of s, 2 for "0.010"
+func decimalPlaces(s string) int {
+	_, frac, _ := strings.Cut(normalizeDecimal(s), ".")
+	return len(frac)
+}
+
+// roundToStep rounds value to a multiple of step, written with the decimals of step
+func roundToStep(value, step string, rounding Rounding) (string, error) {
+	v, err := parseDecimal("value", value)
+	if err != nil {
+		return "", err
+	}
+	if step == "" {
+		return "", errors.New("the instrument has no step")
+	}
+	s, err := parseDecimal("step", step)
+	if err != nil {
+		return "", err
+	}
+	if s.Sign() <= 0 {
+		return "", fmt.Errorf("step %s must be positive", step)
+	}
+
+	q := new(big.Rat).Quo(v, s)
+	// the denominator is positive, so Div is the floor
+	n := new(big.Int).Div(q.Num(), q.Denom())
+	if rounding == RoundingCeil && !q.IsInt() {
+		n.Add(n, big.NewInt(1))
+	}
+	rounded := new(big.Rat).Mul(new(big.Rat).SetInt(n), s)
+	return rounded.FloatString(decimalPlaces(step)), nil
+}
+
+func checkStep(name, raw string, value *big.Rat, step string) error {
+	if step == "" {
+		return nil
+	}
+	s, err := parseDecimal("step", step)
+	if err != nil || s.Sign() <= 0 {
+		return nil
+	}
+	if !new(big.Rat).Quo(value, s).IsInt() {
+		return fmt.Errorf("%w: %s %s is not a multiple of %s", ErrInvalidPrecision, name, raw, step)
+	}
+	return nil
+}
+
+// checkRange ignores empty and zero bounds, which Bybit uses for no limit
+func checkRange(name, raw string, value *big.Rat, minimum, maximum string) error {
+	if minimum != "" {
+		if m, err := parseDecimal("minimum", minimum); err == nil && m.Sign() > 0 && value.Cmp(m) < 0 {
+			return fmt.Errorf("%w: %s %s is below the minimum %s", ErrOrderLimit, name, raw, minimum)
+		}
+	}
+	if maximum != "" {
+		if m, err := parseDecimal("maximum", maximum); err == nil && m.Sign() > 0 && value.Cmp(m) > 0 {
+			return fmt.Errorf("%w: %s %s is above the maximum %s", ErrOrderLimit, name, raw, maximum)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withInstrumentsHandlerOption(calls *int32) func(*http.ServeMux) {
+	linear := []map[string]interface{}{
+		{
+			"symbol": "BTCUSDT", "status": "Trading",
+			"priceFilter":   map[string]string{"minPrice": "0.10", "maxPrice": "199999.80", "tickSize": "0.10"},
+			"lotSizeFilter": map[string]string{"maxOrderQty": "100.000", "minOrderQty": "0.002", "qtyStep": "0.001", "maxMktOrderQty": "50.000", "minNotionalValue": "5"},
+		},
+		{
+			"symbol": "ETHUSDT", "status": "Settling",
+			"priceFilter":   map[string]string{"minPrice": "0.01", "maxPrice": "19999.98", "tickSize": "0.01"},
+			"lotSizeFilter": map[string]string{"maxOrderQty": "1000.00", "minOrderQty": "0.01", "qtyStep": "0.01"},
+		},
+	}
+	return func(mux *http.ServeMux) {
+		mux.HandleFunc("/v5/market/instruments-info", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(calls, 1)
+			query := r.URL.Query()
+			result := map[string]interface{}{"category": query.Get("category")}
+			switch query.Get("category") {
+			case "linear":
+				// one instrument per page
+				if query.Get("cursor") == "" {
+					result["list"], result["nextPageCursor"] = linear[:1], "next"
+				} else {
+					result["list"], result["nextPageCursor"] = linear[1:], ""
+				}
+			case "spot":
+				result["list"] = []map[string]interface{}{{
+					"symbol": "BTCUSDT", "status": "Trading",
+					"priceFilter":   map[string]string{"tickSize": "0.01"},
+					"lotSizeFilter": map[string]string{"basePrecision": "0.000001", "quotePrecision": "0.00000001", "minOrderQty": "0.000048", "maxOrderQty": "71.73956243", "minOrderAmt": "1", "maxOrderAmt": "2000000"},
+				}}
+			default:
+				result["list"] = []interface{}{}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"retCode": 0, "result": result})
+		})
+	}
+}
+
+func TestInstrumentCache(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(withInstrumentsHandlerOption(&calls))
+	defer teardown()
+
+	cache := NewInstrumentCache(NewTestClient().WithBaseURL(server.URL).V5().Market())
+	_, err := cache.RoundPrice(CategoryV5Linear, SymbolV5BTCUSDT, "1", RoundingFloor)
+	require.Error(t, err)
+
+	require.NoError(t, cache.Refresh(context.Background()))
+	assert.Equal(t, int32(4), calls) // spot, linear twice, inverse
+	assert.False(t, cache.LoadedAt().IsZero())
+	assert.Len(t, cache.Instruments(CategoryV5Linear), 2)
+
+	btc, ok := cache.Instrument(CategoryV5Linear, SymbolV5BTCUSDT)
+	require.True(t, ok)
+	assert.Equal(t, "0.10", btc.TickSize)
+	assert.Equal(t, "5", btc.MinNotionalValue)
+
+	t.Run("round", func(t *testing.T) {
+		price, err := cache.RoundPrice(CategoryV5Linear, SymbolV5BTCUSDT, "42000.17", RoundingFloor)
+		require.NoError(t, err)
+		assert.Equal(t, "42000.1", price)
+		price, err = cache.RoundPrice(CategoryV5Linear, SymbolV5BTCUSDT, "42000.11", RoundingCeil)
+		require.NoError(t, err)
+		assert.Equal(t, "42000.2", price)
+		price, err = cache.RoundPrice(CategoryV5Linear, SymbolV5BTCUSDT, "42000.2", RoundingCeil)
+		require.NoError(t, err)
+		assert.Equal(t, "42000.2", price)
+
+		qty, err := cache.RoundQty(CategoryV5Spot, SymbolV5BTCUSDT, "0.12345678", RoundingFloor)
+		require.NoError(t, err)
+		assert.Equal(t, "0.123456", qty)
+		qty, err = cache.RoundQty(CategoryV5Linear, SymbolV5BTCUSDT, "1.0001", RoundingCeil)
+		require.NoError(t, err)
+		assert.Equal(t, "1.001", qty)
+
+		_, err = cache.RoundQty(CategoryV5Linear, SymbolV5BTCUSDT, "1e3", RoundingCeil)
+		assert.Error(t, err)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		limit := func(qty, price string) V5CreateOrderParam {
+			return V5CreateOrderParam{Category: CategoryV5Linear, Symbol: SymbolV5BTCUSDT, Side: SideBuy, OrderType: OrderTypeLimit, Qty: qty, Price: &price}
+		}
+		market := func(category CategoryV5, side Side, qty string) V5CreateOrderParam {
+			return V5CreateOrderParam{Category: category, Symbol: SymbolV5BTCUSDT, Side: side, OrderType: OrderTypeMarket, Qty: qty}
+		}
+		baseCoin := MarketUnitBaseCoin
+		spotBase := market(CategoryV5Spot, SideBuy, "0.0001234")
+		spotBase.MarketUnit = &baseCoin
+
+		tests := []struct {
+			name  string
+			param V5CreateOrderParam
+			want  error
+		}{
+			{"valid limit", limit("0.01", "42000.1"), nil},
+			{"qty off step", limit("0.0015", "42000.1"), ErrInvalidPrecision},
+			{"price off tick", limit("0.01", "42000.15"), ErrInvalidPrecision},
+			{"qty below minimum", limit("0.001", "42000.1"), ErrOrderLimit},
+			{"qty above maximum", limit("100.001", "42000.1"), ErrOrderLimit},
+			{"value below minimum", limit("0.002", "2000"), ErrOrderLimit},
+			{"market qty above market maximum", market(CategoryV5Linear, SideSell, "60"), ErrOrderLimit},
+			{"spot market buy in quote coin", market(CategoryV5Spot, SideBuy, "10.5"), nil},
+			{"spot market buy below minimum amount", market(CategoryV5Spot, SideBuy, "0.5"), ErrOrderLimit},
+			{"spot market buy in base coin", spotBase, ErrInvalidPrecision},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := cache.ValidateOrder(tt.param)
+				if tt.want == nil {
+					assert.NoError(t, err)
+					return
+				}
+				assert.True(t, errors.Is(err, tt.want), "%v", err)
+			})
+		}
+
+		t.Run("market order value at a reference price", func(t *testing.T) {
+			param := market(CategoryV5Linear, SideBuy, "0.002")
+			assert.NoError(t, cache.ValidateOrder(param))
+			assert.NoError(t, cache.ValidateOrderAt(param, "42000.1"))
+			assert.ErrorIs(t, cache.ValidateOrderAt(param, "2000"), ErrOrderLimit)
+			assert.ErrorContains(t, cache.ValidateOrderAt(param, "n/a"), "not a decimal")
+
+			sell := market(CategoryV5Spot, SideSell, "0.000048")
+			assert.ErrorIs(t, cache.ValidateOrderAt(sell, "20000"), ErrOrderLimit)
+			assert.NoError(t, cache.ValidateOrderAt(sell, "42000"))
+		})
+
+		err := cache.ValidateOrder(V5CreateOrderParam{Category: CategoryV5Linear, Symbol: SymbolV5("ETHUSDT"), Side: SideBuy, OrderType: OrderTypeMarket, Qty: "1"})
+		assert.ErrorContains(t, err, "Settling")
+		err = cache.ValidateOrder(V5CreateOrderParam{Category: CategoryV5Linear, Symbol: SymbolV5("XRPUSDT"), Qty: "1"})
+		assert.ErrorContains(t, err, "not in the cache")
+	})
+}
+
+func TestInstrumentCache_OptionBaseCoins(t *testing.T) {
+	var baseCoins []string
+	server, teardown := testhelper.NewServer(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v5/market/instruments-info", func(w http.ResponseWriter, r *http.Request) {
+			baseCoin := r.URL.Query().Get("baseCoin")
+			baseCoins = append(baseCoins, baseCoin)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"retCode": 0, "result": map[string]interface{}{
+				"category": "option",
+				"list": []map[string]interface{}{{
+					"symbol": baseCoin + "-27DEC24-100000-C", "status": "Trading",
+					"priceFilter":   map[string]string{"tickSize": "5"},
+					"lotSizeFilter": map[string]string{"qtyStep": "0.01"},
+				}},
+			}})
+		})
+	})
+	defer teardown()
+	market := NewTestClient().WithBaseURL(server.URL).V5().Market()
+
+	cache := NewInstrumentCache(market).WithCategories(CategoryV5Option)
+	assert.ErrorContains(t, cache.Refresh(context.Background()), "WithOptionBaseCoins")
+
+	cache = NewInstrumentCache(market).WithCategories().WithOptionBaseCoins(CoinBTC, CoinETH)
+	require.NoError(t, cache.Refresh(context.Background()))
+	assert.Equal(t, []string{"BTC", "ETH"}, baseCoins)
+	assert.Len(t, cache.Instruments(CategoryV5Option), 2)
+	_, ok := cache.Instrument(CategoryV5Option, SymbolV5("ETH-27DEC24-100000-C"))
+	assert.True(t, ok)
+}
+
+func TestInstrumentCache_RefreshKeepsInstrumentsOnError(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/market/instruments-info", &calls,
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+				"retCode": 0,
+				"result": map[string]interface{}{
+					"category": "linear",
+					"list":     []map[string]interface{}{{"symbol": "BTCUSDT", "priceFilter": map[string]string{"tickSize": "0.5"}}},
+				},
+			}),
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 10001, "retMsg": "params error"}),
+		),
+	)
+	defer teardown()
+
+	cache := NewInstrumentCache(NewTestClient().WithBaseURL(server.URL).V5().Market()).WithCategories(CategoryV5Linear)
+	require.NoError(t, cache.Refresh(context.Background()))
+	require.Error(t, cache.Refresh(context.Background()))
+
+	price, err := cache.RoundPrice(CategoryV5Linear, SymbolV5BTCUSDT, "100.7", RoundingFloor)
+	require.NoError(t, err)
+	assert.Equal(t, "100.5", price)
+}
@@ -1,13 +1,24 @@
 package bybit
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"log"
+	"log/slog"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 )
 
 var logger *log.Logger = newNoopLogger()
 
+// SetLogger : sets the logger of the spot v1 websocket services which have no client
+// Deprecated: use Client.WithSlogHandler or WebSocketClient.WithSlogHandler instead.
 func SetLogger(l *log.Logger) {
 	if l != nil {
 		// Use provided logger.
@@ -18,10 +29,253 @@ func SetLogger(l *log.Logger) {
 	}
 }
 
-func newDefaultLogger() *log.Logger {
-	return log.New(os.Stderr, "Bybit-golang", log.LstdFlags)
-}
-
 func newNoopLogger() *log.Logger {
 	return log.New(io.Discard, "", log.LstdFlags)
 }
+
+// redactedValue replaces sensitive values in logs
+const redactedValue = "[REDACTED]"
+
+// sensitiveLogKeys are matched case-insensitively
+var sensitiveLogKeys = map[string]struct{}{
+	"x-bapi-api-key": {},
+	"x-bapi-sign":    {},
+	"sign":           {},
+	"signature":      {},
+	"api_key":        {},
+	"apikey":         {},
+	"secret":         {},
+}
+
+// isSensitiveLogKey reports whether the value of the attribute, header or field must not be logged
+// Any key containing "address", e.g. address or toAddress, is also sensitive.
+func isSensitiveLogKey(key string) bool {
+	key = strings.ToLower(key)
+	if _, ok := sensitiveLogKeys[key]; ok {
+		return true
+	}
+	return strings.Contains(key, "address")
+}
+
+// NewRedactingHandler : wraps the handler so that the values of sensitive attributes are redacted
+// Client.WithSlogHandler and WebSocketClient.WithSlogHandler wrap the given handler with it.
+func NewRedactingHandler(h slog.Handler) slog.Handler {
+	if _, ok := h.(*redactingHandler); ok {
+		return h
+	}
+	return &redactingHandler{next: h}
+}
+
+type redactingHandler struct {
+	next slog.Handler
+}
+
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	redacted := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		redacted.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = redactAttr(a)
+	}
+	return &redactingHandler{next: h.next.WithAttrs(redacted)}
+}
+
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	if isSensitiveLogKey(a.Key) {
+		return slog.String(a.Key, redactedValue)
+	}
+	a.Value = a.Value.Resolve()
+	if a.Value.Kind() != slog.KindGroup {
+		return a
+	}
+	group := a.Value.Group()
+	redacted := make([]slog.Attr, len(group))
+	for i, ga := range group {
+		redacted[i] = redactAttr(ga)
+	}
+	return slog.Attr{Key: a.Key, Value: slog.GroupValue(redacted...)}
+}
+
+// discardHandler drops every record
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+var discardLogger = slog.New(discardHandler{})
+
+// defaultSlogLogger writes debug logs to stderr, used by WithDebug without any logger
+var defaultSlogLogger = sync.OnceValue(func() *slog.Logger {
+	return newLogLoggerSlogLogger(log.New(os.Stderr, "Bybit-golang", log.LstdFlags))
+})
+
+// newLogLoggerSlogLogger adapts a *log.Logger, keeping its prefix and flags
+func newLogLoggerSlogLogger(l *log.Logger) *slog.Logger {
+	h := slog.NewTextHandler(logLoggerWriter{l}, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// the *log.Logger prints its own timestamp
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	return slog.New(NewRedactingHandler(h))
+}
+
+type logLoggerWriter struct {
+	logger *log.Logger
+}
+
+func (w logLoggerWriter) Write(p []byte) (int, error) {
+	if err := w.logger.Output(2, string(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// headerAttr logs the header as a group, so that the handler can redact single entries
+func headerAttr(key string, header http.Header) slog.Attr {
+	attrs := make([]slog.Attr, 0, len(header))
+	for k, v := range header {
+		attrs = append(attrs, slog.String(k, strings.Join(v, ",")))
+	}
+	return slog.Attr{Key: key, Value: slog.GroupValue(attrs...)}
+}
+
+// queryAttr logs the query as a group, so that the handler can redact single entries
+func queryAttr(key string, query url.Values) slog.Attr {
+	attrs := make([]slog.Attr, 0, len(query))
+	for k, v := range query {
+		attrs = append(attrs, slog.String(k, strings.Join(v, ",")))
+	}
+	return slog.Attr{Key: key, Value: slog.GroupValue(attrs...)}
+}
+
+// errorAttr logs err with the sensitive query values of a failed request redacted
+// The message of *url.Error holds the full url, which carries api_key and sign on legacy endpoints.
+func errorAttr(key string, err error) slog.Attr {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return slog.Any(key, err)
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		return slog.String(key, strings.ReplaceAll(err.Error(), urlErr.URL, redactedValue))
+	}
+	if u.RawQuery == "" {
+		return slog.Any(key, err)
+	}
+	query := u.Query()
+	for k := range query {
+		if isSensitiveLogKey(k) {
+			query.Set(k, redactedValue)
+		}
+	}
+	u.RawQuery = query.Encode()
+	return slog.String(key, strings.ReplaceAll(err.Error(), urlErr.URL, u.String()))
+}
+
+// jsonLogValue is a JSON document logged with its sensitive fields redacted
+type jsonLogValue []byte
+
+// LogValue :
+func (v jsonLogValue) LogValue() slog.Value {
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(v))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return slog.StringValue(redactedValue)
+	}
+	redacted, err := json.Marshal(redactJSON(doc))
+	if err != nil {
+		return slog.StringValue(redactedValue)
+	}
+	return slog.StringValue(string(redacted))
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if isSensitiveLogKey(k) {
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = redactJSON(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactJSON(child)
+		}
+	}
+	return v
+}
+
+// requestLogAttrs returns the endpoint, category and symbol of the request
+// the body is read through GetBody, leaving the request body untouched
+func requestLogAttrs(req *http.Request) []slog.Attr {
+	query := req.URL.Query()
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("endpoint", req.URL.Path),
+	}
+
+	params := struct {
+		Category string `json:"category"`
+		Symbol   string `json:"symbol"`
+	}{
+		Category: query.Get("category"),
+		Symbol:   query.Get("symbol"),
+	}
+	if req.GetBody != nil && req.Header.Get("Content-Type") == "application/json" {
+		if body, err := req.GetBody(); err == nil {
+			_ = json.NewDecoder(body).Decode(&params)
+			_ = body.Close()
+		}
+	}
+	if params.Category != "" {
+		attrs = append(attrs, slog.String("category", params.Category))
+	}
+	if params.Symbol != "" {
+		attrs = append(attrs, slog.String("symbol", params.Symbol))
+	}
+	return attrs
+}
+
+// retCodeFromBody returns the retCode of V5 responses or the ret_code of legacy ones
+func retCodeFromBody(body []byte) (int, bool) {
+	var codes struct {
+		RetCode       *int `json:"retCode"`
+		LegacyRetCode *int `json:"ret_code"`
+	}
+	if err := json.Unmarshal(body, &codes); err != nil {
+		return 0, false
+	}
+	switch {
+	case codes.RetCode != nil:
+		return *codes.RetCode, true
+	case codes.LegacyRetCode != nil:
+		return *codes.LegacyRetCode, true
+	default:
+		return 0, false
+	}
+}
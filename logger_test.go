@@ -0,0 +1,187 @@
+package bybit
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var m map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &m))
+		lines = append(lines, m)
+	}
+	return lines
+}
+
+func TestClient_WithSlogHandler(t *testing.T) {
+	param := V5GetWithdrawalRecordsParam{Coin: testhelper.Ptr(CoinUSDT)}
+
+	t.Run("redacts secrets and logs attributes", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/asset/withdraw/query-record", &calls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+					"retCode": 0,
+					"result": map[string]interface{}{
+						"rows": []map[string]interface{}{
+							{"coin": "USDT", "toAddress": "0xdeadbeef", "amount": "10"},
+						},
+					},
+				}),
+			),
+		)
+		defer teardown()
+
+		var buf bytes.Buffer
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("my-api-key", "my-secret").
+			WithSlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		_, err := client.V5().Asset().GetWithdrawalRecords(param)
+		require.NoError(t, err)
+
+		out := buf.String()
+		assert.NotContains(t, out, "my-api-key")
+		assert.NotContains(t, out, "0xdeadbeef")
+		assert.Contains(t, out, redactedValue)
+
+		lines := decodeLogLines(t, &buf)
+		require.Len(t, lines, 2)
+		assert.Equal(t, "request", lines[0]["msg"])
+		header := lines[0]["header"].(map[string]interface{})
+		assert.Equal(t, redactedValue, header["X-Bapi-Sign"])
+		assert.Equal(t, redactedValue, header["X-Bapi-Api-Key"])
+
+		assert.Equal(t, "response", lines[1]["msg"])
+		assert.Equal(t, "DEBUG", lines[1]["level"])
+		assert.Equal(t, "/v5/asset/withdraw/query-record", lines[1]["endpoint"])
+		assert.Equal(t, float64(0), lines[1]["retCode"])
+		assert.Equal(t, float64(http.StatusOK), lines[1]["status"])
+		assert.Contains(t, lines[1], "latency")
+	})
+	t.Run("logs failures at warn level", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &calls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 10001, "retMsg": "params error"}),
+			),
+		)
+		defer teardown()
+
+		var buf bytes.Buffer
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("my-api-key", "my-secret").
+			WithSlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+		_, err := client.V5().Order().CreateOrder(V5CreateOrderParam{
+			Category:  CategoryV5Linear,
+			Symbol:    SymbolV5BTCUSDT,
+			Side:      SideBuy,
+			OrderType: OrderTypeMarket,
+			Qty:       "0.01",
+		})
+		require.Error(t, err)
+
+		lines := decodeLogLines(t, &buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "WARN", lines[0]["level"])
+		assert.Equal(t, "linear", lines[0]["category"])
+		assert.Equal(t, "BTCUSDT", lines[0]["symbol"])
+		assert.Equal(t, float64(10001), lines[0]["retCode"])
+	})
+	t.Run("legacy logger", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/asset/withdraw/query-record", &calls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		var buf bytes.Buffer
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("my-api-key", "my-secret").
+			WithLogger(log.New(&buf, "bybit ", 0))
+
+		_, err := client.V5().Asset().GetWithdrawalRecords(param)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(buf.String(), "bybit level=DEBUG msg=request"))
+		assert.NotContains(t, buf.String(), "my-api-key")
+	})
+	t.Run("disabled without debug", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/asset/withdraw/query-record", &calls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		var buf bytes.Buffer
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("my-api-key", "my-secret").
+			WithSlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})).
+			WithDebug(false)
+
+		_, err := client.V5().Asset().GetWithdrawalRecords(param)
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+	t.Run("redacts the url of network errors", func(t *testing.T) {
+		var buf bytes.Buffer
+		client := NewTestClient().
+			WithBaseURL("http://127.0.0.1:1").
+			WithAuth("my-api-key", "my-secret").
+			WithSlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+		_, err := client.Spot().V1().SpotGetWalletBalance()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "my-api-key")
+
+		lines := decodeLogLines(t, &buf)
+		require.Len(t, lines, 1)
+		assert.NotContains(t, buf.String(), "my-api-key")
+		assert.Contains(t, lines[0]["error"], "/spot/v1/account?api_key=%5BREDACTED%5D")
+		assert.Contains(t, lines[0]["error"], "sign=%5BREDACTED%5D")
+	})
+	t.Run("default logger is built once", func(t *testing.T) {
+		client := NewTestClient().WithDebug(true)
+		assert.Same(t, client.log(), client.log())
+		assert.Same(t, client.log(), NewWebsocketClient().WithDebug(true).log())
+	})
+}
+
+func TestRedactingHandler(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(NewRedactingHandler(slog.NewJSONHandler(&buf, nil))).
+		With(slog.String("api_key", "key"))
+
+	l.Info("msg",
+		slog.Group("params", slog.String("sign", "abc"), slog.String("coin", "USDT")),
+		slog.String("Address", "0x1"),
+	)
+
+	lines := decodeLogLines(t, &buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, redactedValue, lines[0]["api_key"])
+	assert.Equal(t, redactedValue, lines[0]["Address"])
+	assert.Equal(t, map[string]interface{}{"sign": redactedValue, "coin": "USDT"}, lines[0]["params"])
+}
@@ -0,0 +1,149 @@
+package bybit
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// MetricsSink : receives the measurements of Client and the V5 websocket services
+// Implementations must be safe for concurrent use and should not block.
+type MetricsSink interface {
+	ObserveRequest(RequestMetric)
+	ObserveWebsocketConnect(WebsocketConnectMetric)
+	ObserveWebsocketDisconnect(WebsocketDisconnectMetric)
+	ObserveWebsocketMessage(WebsocketMessageMetric)
+}
+
+// RequestMetric : a single HTTP attempt of a REST call
+type RequestMetric struct {
+	Method   string
+	Endpoint string
+	Category CategoryV5
+	// StatusCode is 0 when no response was received
+	StatusCode int
+	// RetCode is valid when HasRetCode is true, i.e. Bybit answered with a body
+	RetCode    int
+	HasRetCode bool
+	Latency    time.Duration
+	Err        error
+}
+
+// WebsocketService : label of the V5 websocket services
+type WebsocketService string
+
+const (
+	// WebsocketServicePublic :
+	WebsocketServicePublic = WebsocketService("public")
+	// WebsocketServicePrivate :
+	WebsocketServicePrivate = WebsocketService("private")
+	// WebsocketServiceTrade :
+	WebsocketServiceTrade = WebsocketService("trade")
+)
+
+// WebsocketConnectMetric : a dial, every connect after the first one of a service is a reconnect
+type WebsocketConnectMetric struct {
+	Service  WebsocketService
+	Category CategoryV5
+	Err      error
+}
+
+// WebsocketDisconnectMetric : the read loop of Start ended
+type WebsocketDisconnectMetric struct {
+	Service  WebsocketService
+	Category CategoryV5
+	Err      error
+}
+
+// WebsocketMessageMetric : a message received
+type WebsocketMessageMetric struct {
+	Service  WebsocketService
+	Category CategoryV5
+	// Topic is the topic of the message, e.g. orderbook.50.BTCUSDT, or its op for control messages
+	Topic string
+}
+
+// WithMetrics :
+func (c *Client) WithMetrics(sink MetricsSink) *Client {
+	c.metrics = sink
+
+	return c
+}
+
+// WithMetrics : reports from the V5 websocket services
+func (c *WebSocketClient) WithMetrics(sink MetricsSink) *WebSocketClient {
+	c.metrics = sink
+
+	return c
+}
+
+func (c *Client) observeRequest(call *Call, latency time.Duration, err error) {
+	if c.metrics == nil {
+		return
+	}
+	m := RequestMetric{
+		Method:   call.Endpoint.Method,
+		Endpoint: call.Endpoint.Path,
+		Category: call.Endpoint.Category,
+		Latency:  latency,
+		Err:      err,
+	}
+	if resp := call.HTTPResponse; resp != nil {
+		m.StatusCode = resp.StatusCode
+		if 200 <= resp.StatusCode && resp.StatusCode <= 299 {
+			m.RetCode, m.HasRetCode = retCodeFromError(err)
+		}
+	}
+	c.metrics.ObserveRequest(m)
+}
+
+// retCodeFromError returns 0 for nil and the retCode of the Bybit errors
+func retCodeFromError(err error) (int, bool) {
+	var (
+		errResp        *ErrorResponse
+		rateLimitErr   *RateLimitError
+		rateLimitV5Err *RateLimitV5Error
+	)
+	switch {
+	case err == nil:
+		return 0, true
+	case errors.As(err, &errResp):
+		return errResp.RetCode, true
+	case errors.As(err, &rateLimitV5Err):
+		return rateLimitV5Err.RetCode, true
+	case errors.As(err, &rateLimitErr):
+		return rateLimitErr.RetCode, true
+	default:
+		return 0, false
+	}
+}
+
+func (c *WebSocketClient) observeConnect(service WebsocketService, category CategoryV5, err error) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.ObserveWebsocketConnect(WebsocketConnectMetric{Service: service, Category: category, Err: err})
+}
+
+func (c *WebSocketClient) observeDisconnect(service WebsocketService, category CategoryV5, err error) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.ObserveWebsocketDisconnect(WebsocketDisconnectMetric{Service: service, Category: category, Err: err})
+}
+
+func (c *WebSocketClient) observeMessage(service WebsocketService, category CategoryV5, message []byte) {
+	if c.metrics == nil {
+		return
+	}
+	var parsed struct {
+		Topic string `json:"topic"`
+		Op    string `json:"op"`
+	}
+	_ = json.Unmarshal(message, &parsed)
+	topic := parsed.Topic
+	if topic == "" {
+		topic = parsed.Op
+	}
+	c.metrics.ObserveWebsocketMessage(WebsocketMessageMetric{Service: service, Category: category, Topic: topic})
+}
@@ -0,0 +1,225 @@
+package bybit
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultPrometheusBuckets : request latency buckets in seconds
+var DefaultPrometheusBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics : MetricsSink serving the numbers in the Prometheus text format
+//
+//	bybit_requests_total{method,endpoint,category,status,ret_code}
+//	bybit_request_duration_seconds{method,endpoint,category}
+//	bybit_websocket_connects_total{service,category,result}
+//	bybit_websocket_disconnects_total{service,category}
+//	bybit_websocket_messages_total{service,category,topic}
+//
+// status and ret_code are empty when no response or no body was received.
+type PrometheusMetrics struct {
+	buckets []float64
+
+	mu            sync.Mutex
+	requests      map[string]uint64
+	durations     map[string]*histogram
+	wsConnects    map[string]uint64
+	wsDisconnects map[string]uint64
+	wsMessages    map[string]uint64
+}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewPrometheusMetrics :
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		buckets:       DefaultPrometheusBuckets,
+		requests:      map[string]uint64{},
+		durations:     map[string]*histogram{},
+		wsConnects:    map[string]uint64{},
+		wsDisconnects: map[string]uint64{},
+		wsMessages:    map[string]uint64{},
+	}
+}
+
+// WithBuckets : sets the upper bounds in seconds of the latency histogram, in increasing order
+func (p *PrometheusMetrics) WithBuckets(buckets []float64) *PrometheusMetrics {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.buckets = append([]float64(nil), buckets...)
+	p.durations = map[string]*histogram{}
+
+	return p
+}
+
+// ObserveRequest :
+func (p *PrometheusMetrics) ObserveRequest(m RequestMetric) {
+	status, retCode := "", ""
+	if m.StatusCode != 0 {
+		status = strconv.Itoa(m.StatusCode)
+	}
+	if m.HasRetCode {
+		retCode = strconv.Itoa(m.RetCode)
+	}
+	requestLabels := formatLabels(
+		"method", m.Method,
+		"endpoint", m.Endpoint,
+		"category", string(m.Category),
+		"status", status,
+		"ret_code", retCode,
+	)
+	durationLabels := formatLabels(
+		"method", m.Method,
+		"endpoint", m.Endpoint,
+		"category", string(m.Category),
+	)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[requestLabels]++
+	h, ok := p.durations[durationLabels]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.durations[durationLabels] = h
+	}
+	seconds := m.Latency.Seconds()
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// ObserveWebsocketConnect :
+func (p *PrometheusMetrics) ObserveWebsocketConnect(m WebsocketConnectMetric) {
+	result := "ok"
+	if m.Err != nil {
+		result = "error"
+	}
+	labels := formatLabels("service", string(m.Service), "category", string(m.Category), "result", result)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.wsConnects[labels]++
+}
+
+// ObserveWebsocketDisconnect :
+func (p *PrometheusMetrics) ObserveWebsocketDisconnect(m WebsocketDisconnectMetric) {
+	labels := formatLabels("service", string(m.Service), "category", string(m.Category))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.wsDisconnects[labels]++
+}
+
+// ObserveWebsocketMessage :
+func (p *PrometheusMetrics) ObserveWebsocketMessage(m WebsocketMessageMetric) {
+	labels := formatLabels("service", string(m.Service), "category", string(m.Category), "topic", m.Topic)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.wsMessages[labels]++
+}
+
+// ServeHTTP : writes the metrics in the Prometheus text format
+func (p *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	bw := bufio.NewWriter(w)
+	p.writeTo(bw)
+	_ = bw.Flush()
+}
+
+// ListenAndServe : serves the metrics on addr under /metrics, it blocks like http.ListenAndServe
+func (p *PrometheusMetrics) ListenAndServe(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", p)
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+func (p *PrometheusMetrics) writeTo(w *bufio.Writer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	writeCounter(w, "bybit_requests_total", "REST requests sent, one per attempt.", p.requests)
+
+	fmt.Fprintln(w, "# HELP bybit_request_duration_seconds REST request latency.")
+	fmt.Fprintln(w, "# TYPE bybit_request_duration_seconds histogram")
+	for _, labels := range sortedKeys(p.durations) {
+		h := p.durations[labels]
+		for i, bound := range p.buckets {
+			fmt.Fprintf(w, "bybit_request_duration_seconds_bucket%s %d\n",
+				appendLabel(labels, "le", strconv.FormatFloat(bound, 'g', -1, 64)), h.counts[i])
+		}
+		fmt.Fprintf(w, "bybit_request_duration_seconds_bucket%s %d\n", appendLabel(labels, "le", "+Inf"), h.count)
+		fmt.Fprintf(w, "bybit_request_duration_seconds_sum%s %s\n", labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "bybit_request_duration_seconds_count%s %d\n", labels, h.count)
+	}
+
+	writeCounter(w, "bybit_websocket_connects_total", "Websocket dials, reconnects included.", p.wsConnects)
+	writeCounter(w, "bybit_websocket_disconnects_total", "Websocket read loops ended.", p.wsDisconnects)
+	writeCounter(w, "bybit_websocket_messages_total", "Websocket messages received.", p.wsMessages)
+}
+
+func writeCounter(w *bufio.Writer, name, help string, values map[string]uint64) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s counter\n", name)
+	for _, labels := range sortedKeys(values) {
+		fmt.Fprintf(w, "%s%s %d\n", name, labels, values[labels])
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels formats name/value pairs as {name="value",...}
+func formatLabels(pairs ...string) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(pairs[i])
+		b.WriteString(`="`)
+		b.WriteString(labelValueReplacer.Replace(pairs[i+1]))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func appendLabel(labels, name, value string) string {
+	return strings.TrimSuffix(labels, "}") + "," + strings.TrimPrefix(formatLabels(name, value), "{")
+}
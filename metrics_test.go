@@ -0,0 +1,113 @@
+package bybit
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrapeMetrics(t *testing.T, metrics *PrometheusMetrics) string {
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestClient_WithMetrics(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/order/create", &calls,
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 10001, "retMsg": "params error"}),
+			respondJSON(t, http.StatusBadGateway, nil, nil),
+		),
+	)
+	defer teardown()
+
+	metrics := NewPrometheusMetrics().WithBuckets([]float64{0.5, 30})
+	client := NewTestClient().
+		WithBaseURL(server.URL).
+		WithAuth("test", "test").
+		WithMetrics(metrics)
+
+	param := V5CreateOrderParam{
+		Category:  CategoryV5Linear,
+		Symbol:    SymbolV5BTCUSDT,
+		Side:      SideBuy,
+		OrderType: OrderTypeMarket,
+		Qty:       "0.01",
+	}
+	_, err := client.V5().Order().CreateOrder(param)
+	require.Error(t, err)
+	_, err = client.V5().Order().CreateOrder(param)
+	require.ErrorIs(t, err, ErrServerError)
+
+	out := scrapeMetrics(t, metrics)
+	assert.Contains(t, out, `bybit_requests_total{method="POST",endpoint="/v5/order/create",category="linear",status="200",ret_code="10001"} 1`)
+	assert.Contains(t, out, `bybit_requests_total{method="POST",endpoint="/v5/order/create",category="linear",status="502",ret_code=""} 1`)
+	assert.Contains(t, out, `bybit_request_duration_seconds_bucket{method="POST",endpoint="/v5/order/create",category="linear",le="30"} 2`)
+	assert.Contains(t, out, `bybit_request_duration_seconds_bucket{method="POST",endpoint="/v5/order/create",category="linear",le="+Inf"} 2`)
+	assert.Contains(t, out, `bybit_request_duration_seconds_count{method="POST",endpoint="/v5/order/create",category="linear"} 2`)
+}
+
+func TestWebSocketClient_WithMetrics(t *testing.T) {
+	bytesBody, err := json.Marshal(map[string]interface{}{
+		"topic": "publicTrade.BTCUSDT",
+		"type":  "snapshot",
+		"ts":    1672304486868,
+		"data":  []map[string]interface{}{},
+	})
+	require.NoError(t, err)
+
+	category := CategoryV5Linear
+	server, teardown := testhelper.NewWebsocketServer(
+		testhelper.WithWebsocketHandlerOption(V5WebsocketPublicPathFor(category), bytesBody),
+	)
+	defer teardown()
+
+	metrics := NewPrometheusMetrics()
+	wsClient := NewTestWebsocketClient().
+		WithBaseURL(server.URL).
+		WithMetrics(metrics)
+
+	svc, err := wsClient.V5().Public(category)
+	require.NoError(t, err)
+	_, err = svc.SubscribeTrade(
+		V5WebsocketPublicTradeParamKey{Symbol: SymbolV5BTCUSDT},
+		func(V5WebsocketPublicTradeResponse) error { return nil },
+	)
+	require.NoError(t, err)
+	require.NoError(t, svc.Run())
+	require.NoError(t, svc.Close())
+
+	out := scrapeMetrics(t, metrics)
+	assert.Contains(t, out, `bybit_websocket_connects_total{service="public",category="linear",result="ok"} 1`)
+	assert.Contains(t, out, `bybit_websocket_messages_total{service="public",category="linear",topic="publicTrade.BTCUSDT"} 1`)
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	metrics := NewPrometheusMetrics().WithBuckets([]float64{0.1, 1})
+	metrics.ObserveRequest(RequestMetric{Method: http.MethodGet, Endpoint: "/v5/market/time", Latency: 50 * time.Millisecond, HasRetCode: true, StatusCode: 200})
+	metrics.ObserveRequest(RequestMetric{Method: http.MethodGet, Endpoint: "/v5/market/time", Latency: 500 * time.Millisecond, HasRetCode: true, StatusCode: 200})
+	metrics.ObserveWebsocketDisconnect(WebsocketDisconnectMetric{Service: WebsocketServicePrivate})
+	metrics.ObserveWebsocketMessage(WebsocketMessageMetric{Service: WebsocketServicePrivate, Topic: "order\"x"})
+
+	out := scrapeMetrics(t, metrics)
+	assert.Contains(t, out, "# TYPE bybit_request_duration_seconds histogram\n")
+	assert.Contains(t, out, `bybit_requests_total{method="GET",endpoint="/v5/market/time",category="",status="200",ret_code="0"} 2`)
+	assert.Contains(t, out, `bybit_request_duration_seconds_bucket{method="GET",endpoint="/v5/market/time",category="",le="0.1"} 1`)
+	assert.Contains(t, out, `bybit_request_duration_seconds_bucket{method="GET",endpoint="/v5/market/time",category="",le="1"} 2`)
+	assert.Contains(t, out, `bybit_request_duration_seconds_sum{method="GET",endpoint="/v5/market/time",category=""} 0.55`)
+	assert.Contains(t, out, `bybit_websocket_disconnects_total{service="private",category=""} 1`)
+	assert.Contains(t, out, `bybit_websocket_messages_total{service="private",category="",topic="order\"x"} 1`)
+}
@@ -0,0 +1,78 @@
+package bybit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrEndpointBlocked : the middleware refused to send the request
+var ErrEndpointBlocked = errors.New("endpoint blocked")
+
+// Endpoint : the logical endpoint of a REST call
+type Endpoint struct {
+	Method   string
+	Path     string
+	Category CategoryV5
+	// Param is the param struct given to the V5 service method, nil when the method takes none
+	// and for the deprecated endpoints.
+	Param interface{}
+}
+
+// Call : a single attempt of a REST call passed through the middleware chain
+type Call struct {
+	Endpoint Endpoint
+	// Request is the signed request, headers can be added without breaking the signature
+	Request *http.Request
+	// Response is the destination of the decoded body, e.g. *V5CreateOrderResponse,
+	// filled once the next handler returned
+	Response interface{}
+	// HTTPResponse is set once the next handler returned, nil on network errors, its body is closed
+	HTTPResponse *http.Response
+	// Attempt starts from 1 and increases when the retry policy resends the call
+	Attempt int
+}
+
+// CallHandler : sends the call, the innermost handler performs the HTTP request
+type CallHandler func(ctx context.Context, call *Call) error
+
+// Middleware : wraps a CallHandler, e.g. to audit, measure, inject faults or block endpoints
+// A middleware returning an error without calling next prevents the request from being sent.
+type Middleware func(next CallHandler) CallHandler
+
+// WithMiddleware : appends middleware, the first one added is the outermost
+// Middleware runs for every attempt, after the rate limiter and inside the retry policy.
+func (c *Client) WithMiddleware(middleware ...Middleware) *Client {
+	c.middleware = append(c.middleware[:len(c.middleware):len(c.middleware)], middleware...)
+
+	return c
+}
+
+// send passes the call through the middleware chain
+func (c *Client) send(ctx context.Context, call *Call) error {
+	handler := CallHandler(func(ctx context.Context, call *Call) error {
+		start := time.Now()
+		resp, err := c.request(call.Request, call.Response)
+		call.HTTPResponse = resp
+		c.observeRequest(call, time.Since(start), err)
+		return err
+	})
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		handler = c.middleware[i](handler)
+	}
+	return handler(ctx, call)
+}
+
+// ReadOnly : blocks every request except GET, for deployments that must not trade or move funds
+func ReadOnly() Middleware {
+	return func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) error {
+			if call.Endpoint.Method != http.MethodGet {
+				return fmt.Errorf("%w: %s %s in read-only mode", ErrEndpointBlocked, call.Endpoint.Method, call.Endpoint.Path)
+			}
+			return next(ctx, call)
+		}
+	}
+}
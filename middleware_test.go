@@ -0,0 +1,141 @@
+package bybit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithMiddleware(t *testing.T) {
+	okBody := map[string]interface{}{
+		"retCode": 0,
+		"result": map[string]interface{}{
+			"orderId":     "1358868270414852352",
+			"orderLinkId": "link",
+		},
+	}
+	param := V5CreateOrderParam{
+		Category:  CategoryV5Linear,
+		Symbol:    SymbolV5BTCUSDT,
+		Side:      SideBuy,
+		OrderType: OrderTypeMarket,
+		Qty:       "0.01",
+	}
+
+	t.Run("sees endpoint and decoded response", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &calls,
+				func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "audit", r.Header.Get("X-Custom"))
+					respondJSON(t, http.StatusOK, nil, okBody)(w, r)
+				},
+			),
+		)
+		defer teardown()
+
+		var (
+			order []string
+			seen  *Call
+		)
+		trace := func(name string) Middleware {
+			return func(next CallHandler) CallHandler {
+				return func(ctx context.Context, call *Call) error {
+					order = append(order, name)
+					return next(ctx, call)
+				}
+			}
+		}
+		audit := func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) error {
+				call.Request.Header.Set("X-Custom", "audit")
+				err := next(ctx, call)
+				seen = call
+				return err
+			}
+		}
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithMiddleware(trace("first"), trace("second")).
+			WithMiddleware(audit)
+
+		resp, err := client.V5().Order().CreateOrder(param)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first", "second"}, order)
+
+		require.NotNil(t, seen)
+		assert.Equal(t, Endpoint{
+			Method:   http.MethodPost,
+			Path:     "/v5/order/create",
+			Category: CategoryV5Linear,
+			Param:    param,
+		}, seen.Endpoint)
+		assert.Equal(t, 1, seen.Attempt)
+		assert.Equal(t, http.StatusOK, seen.HTTPResponse.StatusCode)
+		require.IsType(t, &V5CreateOrderResponse{}, seen.Response)
+		assert.Same(t, resp, seen.Response)
+	})
+	t.Run("injected fault is retried", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/market/time", &calls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		var faults int32
+		inject := func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) error {
+				if atomic.AddInt32(&faults, 1) == 1 {
+					return ErrServerError
+				}
+				return next(ctx, call)
+			}
+		}
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithRetryPolicy(RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}).
+			WithMiddleware(inject)
+
+		_, err := client.V5().Market().GetServerTime()
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&faults))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+	t.Run("read only", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &calls,
+				respondJSON(t, http.StatusOK, nil, okBody),
+			),
+			withSequenceHandlerOption("/v5/order/realtime", &calls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithMiddleware(ReadOnly())
+
+		_, err := client.V5().Order().CreateOrder(param)
+		assert.True(t, errors.Is(err, ErrEndpointBlocked))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+		_, err = client.V5().Order().GetOpenOrders(V5GetOpenOrdersParam{Category: CategoryV5Linear})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
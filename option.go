@@ -0,0 +1,346 @@
+package bybit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Option : configures NewV5Client and NewV5WebsocketClient
+// Options which only make sense for one of the clients make the other constructor fail.
+type Option struct {
+	name      string
+	client    func(*Client) error
+	websocket func(*WebSocketClient) error
+
+	// scheme of the base urls set by the option, AccountRegistry applies it to the matching client only
+	scheme string
+	// rateLimiter set by WithRateLimiterOption, AccountRegistry copies it per account
+	rateLimiter *RateLimiter
+}
+
+func clientOption(name string, f func(*Client) error) Option {
+	return Option{name: name, client: f}
+}
+
+func websocketOption(name string, f func(*WebSocketClient) error) Option {
+	return Option{name: name, websocket: f}
+}
+
+// NewV5Client : returns a REST client, validating the options up front
+// Unlike the With... builder methods it never panics on invalid key material.
+func NewV5Client(opts ...Option) (*Client, error) {
+	c := NewClient()
+	for _, opt := range opts {
+		if opt.client == nil {
+			return nil, fmt.Errorf("%s: not supported by the REST client", opt.name)
+		}
+		if err := opt.client(c); err != nil {
+			return nil, fmt.Errorf("%s: %w", opt.name, err)
+		}
+	}
+	if err := validateBaseURL(c.baseURL, "http", "https"); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewV5WebsocketClient : returns a WebSocket client, validating the options up front
+// Unlike the With... builder methods it never panics on invalid key material.
+func NewV5WebsocketClient(opts ...Option) (*WebSocketClient, error) {
+	c := NewWebsocketClient()
+	for _, opt := range opts {
+		if opt.websocket == nil {
+			return nil, fmt.Errorf("%s: not supported by the websocket client", opt.name)
+		}
+		if err := opt.websocket(c); err != nil {
+			return nil, fmt.Errorf("%s: %w", opt.name, err)
+		}
+	}
+	if err := validateBaseURL(c.baseURL, "ws", "wss"); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// baseURLScheme : the scheme of baseURL, empty when it does not parse
+func baseURLScheme(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return ""
+	}
+	return u.Scheme
+}
+
+func validateBaseURL(baseURL string, schemes ...string) error {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("base url: %w", err)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("base url %q: missing host", baseURL)
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("base url %q: scheme must be one of %v", baseURL, schemes)
+}
+
+func validateKey(key string) error {
+	if key == "" {
+		return errors.New("empty api key")
+	}
+	return nil
+}
+
+// WithBaseURLOption :
+func WithBaseURLOption(baseURL string) Option {
+	return Option{
+		name:   "base url",
+		scheme: baseURLScheme(baseURL),
+		client: func(c *Client) error {
+			c.WithBaseURL(baseURL)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			c.WithBaseURL(baseURL)
+			return nil
+		},
+	}
+}
+
+// WithAuthOption : HMAC authentication with a system-generated api key
+func WithAuthOption(key string, secret string) Option {
+	validate := func() error {
+		if err := validateKey(key); err != nil {
+			return err
+		}
+		if secret == "" {
+			return errors.New("empty api secret")
+		}
+		return nil
+	}
+	return Option{
+		name: "auth",
+		client: func(c *Client) error {
+			if err := validate(); err != nil {
+				return err
+			}
+			c.WithAuth(key, secret)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			if err := validate(); err != nil {
+				return err
+			}
+			c.WithAuth(key, secret)
+			return nil
+		},
+	}
+}
+
+// WithAuthRSAOption : authentication with an RSA private key in PEM
+func WithAuthRSAOption(key string, privateKeyPEM string) Option {
+	newSigner := func() (Signer, error) {
+		if err := validateKey(key); err != nil {
+			return nil, err
+		}
+		privateKey, err := LoadRSAPrivateKeyFromBytes([]byte(privateKeyPEM))
+		if err != nil {
+			return nil, err
+		}
+		return NewRSASigner(privateKey), nil
+	}
+	return signerOption("auth rsa", key, newSigner)
+}
+
+// WithAuthEd25519Option : authentication with an Ed25519 private key in PKCS8 PEM
+func WithAuthEd25519Option(key string, privateKeyPEM string) Option {
+	newSigner := func() (Signer, error) {
+		if err := validateKey(key); err != nil {
+			return nil, err
+		}
+		privateKey, err := LoadEd25519PrivateKeyFromBytes([]byte(privateKeyPEM))
+		if err != nil {
+			return nil, err
+		}
+		return NewEd25519Signer(privateKey), nil
+	}
+	return signerOption("auth ed25519", key, newSigner)
+}
+
+// WithSignerOption : authentication with a custom Signer
+func WithSignerOption(key string, signer Signer) Option {
+	newSigner := func() (Signer, error) {
+		if err := validateKey(key); err != nil {
+			return nil, err
+		}
+		if signer == nil {
+			return nil, errors.New("nil signer")
+		}
+		return signer, nil
+	}
+	return signerOption("signer", key, newSigner)
+}
+
+func signerOption(name string, key string, newSigner func() (Signer, error)) Option {
+	return Option{
+		name: name,
+		client: func(c *Client) error {
+			signer, err := newSigner()
+			if err != nil {
+				return err
+			}
+			c.WithSigner(key, signer)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			signer, err := newSigner()
+			if err != nil {
+				return err
+			}
+			c.WithSigner(key, signer)
+			return nil
+		},
+	}
+}
+
+// WithRecvWindowOption :
+func WithRecvWindowOption(recvWindow time.Duration) Option {
+	validate := func() error {
+		if recvWindow <= 0 {
+			return fmt.Errorf("recv window must be positive, got %v", recvWindow)
+		}
+		return nil
+	}
+	return Option{
+		name: "recv window",
+		client: func(c *Client) error {
+			if err := validate(); err != nil {
+				return err
+			}
+			c.WithRecvWindow(recvWindow)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			if err := validate(); err != nil {
+				return err
+			}
+			c.WithRecvWindow(recvWindow)
+			return nil
+		},
+	}
+}
+
+// WithSlogHandlerOption :
+func WithSlogHandlerOption(h slog.Handler) Option {
+	return Option{
+		name: "slog handler",
+		client: func(c *Client) error {
+			if h == nil {
+				return errors.New("nil handler")
+			}
+			c.WithSlogHandler(h)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			if h == nil {
+				return errors.New("nil handler")
+			}
+			c.WithSlogHandler(h)
+			return nil
+		},
+	}
+}
+
+// WithMetricsOption :
+func WithMetricsOption(sink MetricsSink) Option {
+	return Option{
+		name: "metrics",
+		client: func(c *Client) error {
+			c.WithMetrics(sink)
+			return nil
+		},
+		websocket: func(c *WebSocketClient) error {
+			c.WithMetrics(sink)
+			return nil
+		},
+	}
+}
+
+// WithHTTPClientOption : REST only
+func WithHTTPClientOption(httpClient *http.Client) Option {
+	return clientOption("http client", func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("nil http client")
+		}
+		c.WithHTTPClient(httpClient)
+		return nil
+	})
+}
+
+// WithRetryPolicyOption : REST only
+func WithRetryPolicyOption(policy RetryPolicy) Option {
+	return clientOption("retry policy", func(c *Client) error {
+		if policy.MaxRetries < 0 || policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+			return fmt.Errorf("negative value in %+v", policy)
+		}
+		c.WithRetryPolicy(policy)
+		return nil
+	})
+}
+
+// WithRateLimiterOption : REST only
+func WithRateLimiterOption(limiter *RateLimiter) Option {
+	opt := clientOption("rate limiter", func(c *Client) error {
+		if limiter == nil {
+			return errors.New("nil rate limiter")
+		}
+		c.WithRateLimiter(limiter)
+		return nil
+	})
+	opt.rateLimiter = limiter
+	return opt
+}
+
+// WithMiddlewareOption : REST only
+func WithMiddlewareOption(middleware ...Middleware) Option {
+	return clientOption("middleware", func(c *Client) error {
+		c.WithMiddleware(middleware...)
+		return nil
+	})
+}
+
+// WithResponseMetadataOption : REST only
+func WithResponseMetadataOption(enabled bool) Option {
+	return clientOption("response metadata", func(c *Client) error {
+		c.WithResponseMetadata(enabled)
+		return nil
+	})
+}
+
+// WithRefererOption : REST only
+func WithRefererOption(referer string) Option {
+	return clientOption("referer", func(c *Client) error {
+		c.WithReferer(referer)
+		return nil
+	})
+}
+
+// WithDialerOption : WebSocket only
+func WithDialerOption(dialer *websocket.Dialer) Option {
+	return websocketOption("dialer", func(c *WebSocketClient) error {
+		if dialer == nil {
+			return errors.New("nil dialer")
+		}
+		c.WithDialer(dialer)
+		return nil
+	})
+}
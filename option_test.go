@@ -0,0 +1,134 @@
+package bybit
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewV5Client(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/position/list", &calls,
+				func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "10000", r.Header.Get("X-BAPI-RECV-WINDOW"))
+					assert.Equal(t, "2", r.Header.Get("X-BAPI-SIGN-TYPE"))
+					respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 0})(w, r)
+				},
+			),
+		)
+		defer teardown()
+
+		client, err := NewV5Client(
+			WithBaseURLOption(server.URL),
+			WithAuthEd25519Option(TestAPIKey, TestEd25519PrivateKey),
+			WithRecvWindowOption(10*time.Second),
+			WithRetryPolicyOption(DefaultRetryPolicy()),
+		)
+		require.NoError(t, err)
+
+		_, err = client.V5().Position().GetPositionInfo(V5GetPositionInfoParam{Category: CategoryV5Linear})
+		require.NoError(t, err)
+	})
+
+	tests := []struct {
+		name string
+		opts []Option
+		err  string
+	}{
+		{
+			name: "invalid rsa key",
+			opts: []Option{WithAuthRSAOption(TestAPIKey, "not a pem")},
+			err:  "auth rsa: failed to parse PEM block containing the private key",
+		},
+		{
+			name: "ed25519 option with rsa key",
+			opts: []Option{WithAuthEd25519Option(TestAPIKey, TestRSAPrivateKey)},
+			err:  "auth ed25519:",
+		},
+		{
+			name: "empty key",
+			opts: []Option{WithAuthOption("", "secret")},
+			err:  "auth: empty api key",
+		},
+		{
+			name: "empty secret",
+			opts: []Option{WithAuthOption("key", "")},
+			err:  "auth: empty api secret",
+		},
+		{
+			name: "nil signer",
+			opts: []Option{WithSignerOption("key", nil)},
+			err:  "signer: nil signer",
+		},
+		{
+			name: "base url without scheme",
+			opts: []Option{WithBaseURLOption("api.bybit.com")},
+			err:  `base url "api.bybit.com": missing host`,
+		},
+		{
+			name: "websocket base url",
+			opts: []Option{WithBaseURLOption(WebsocketBaseURL)},
+			err:  "scheme must be one of [http https]",
+		},
+		{
+			name: "negative recv window",
+			opts: []Option{WithRecvWindowOption(-time.Second)},
+			err:  "recv window: recv window must be positive",
+		},
+		{
+			name: "websocket only option",
+			opts: []Option{WithDialerOption(websocket.DefaultDialer)},
+			err:  "dialer: not supported by the REST client",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewV5Client(tt.opts...)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+			assert.Nil(t, client)
+		})
+	}
+}
+
+func TestNewV5WebsocketClient(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		wsClient, err := NewV5WebsocketClient(
+			WithAuthRSAOption(TestAPIKey, TestRSAPrivateKey),
+			WithDialerOption(websocket.DefaultDialer),
+		)
+		require.NoError(t, err)
+		assert.True(t, wsClient.hasAuth())
+		assert.Equal(t, WebsocketBaseURL, wsClient.baseURL)
+	})
+	t.Run("rest base url", func(t *testing.T) {
+		_, err := NewV5WebsocketClient(WithBaseURLOption(MainNetBaseURL))
+		assert.ErrorContains(t, err, "scheme must be one of [ws wss]")
+	})
+	t.Run("rest only option", func(t *testing.T) {
+		_, err := NewV5WebsocketClient(WithHTTPClientOption(http.DefaultClient))
+		assert.ErrorContains(t, err, "http client: not supported by the websocket client")
+	})
+}
+
+func TestClient_NoPanic(t *testing.T) {
+	client := NewTestClient().
+		WithBaseURL("://invalid").
+		WithAuth("test", "test")
+
+	t.Run("postForm with invalid base url", func(t *testing.T) {
+		var res struct{}
+		assert.Error(t, client.postForm("/path", nil, &res))
+	})
+	t.Run("postJSON with invalid body", func(t *testing.T) {
+		var res struct{}
+		assert.Error(t, client.WithBaseURL(MainNetBaseURL).postJSON("/path", []byte("{"), &res))
+	})
+}
@@ -0,0 +1,229 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitExceeded :
+// The client side budget of the endpoint group is exhausted and the limiter is in fail fast mode
+var ErrRateLimitExceeded = errors.New("client side rate limit exceeded")
+
+// RateLimiter : client side token buckets for private V5 endpoints
+// Each endpoint group starts from a conservative default budget per second and follows
+// X-Bapi-Limit, X-Bapi-Limit-Status and X-Bapi-Limit-Reset-Timestamp once Bybit returns them.
+// Endpoints of a group can have different limits, the group keeps the lowest one reported.
+// A RateLimiter is safe for concurrent use and can be shared between clients using the same api key.
+type RateLimiter struct {
+	mu       sync.Mutex
+	buckets  map[string]*tokenBucket
+	failFast bool
+}
+
+// RateLimitBudget :
+type RateLimitBudget struct {
+	Group     string
+	Limit     int
+	Remaining int
+	// ResetAt is set when Bybit reported the budget as exhausted
+	ResetAt time.Time
+}
+
+type tokenBucket struct {
+	limit float64
+	// reported is set once X-Bapi-Limit replaced the default limit
+	reported     bool
+	tokens       float64
+	updatedAt    time.Time
+	blockedUntil time.Time
+}
+
+// NewRateLimiter :
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// WithFailFast : return ErrRateLimitExceeded instead of blocking until a token is available
+func (l *RateLimiter) WithFailFast(failFast bool) *RateLimiter {
+	l.failFast = failFast
+
+	return l
+}
+
+// clone : a limiter with the same settings and fresh budgets
+func (l *RateLimiter) clone() *RateLimiter {
+	return NewRateLimiter().WithFailFast(l.failFast)
+}
+
+// WithRateLimiter :
+func (c *Client) WithRateLimiter(limiter *RateLimiter) *Client {
+	c.rateLimiter = limiter
+
+	return c
+}
+
+// RateLimitGroup returns the RateLimiter bucket of a V5 path.
+// Order create, amend, cancel and cancel-all are limited per category,
+// other endpoints share a bucket per section such as "position", "account" or "asset".
+func RateLimitGroup(path string, category CategoryV5) string {
+	path = strings.TrimPrefix(path, "/v5/")
+	switch path {
+	case "order/create", "order/amend", "order/cancel", "order/cancel-all":
+		if category != "" {
+			return path + ":" + string(category)
+		}
+		return path
+	}
+	section, _, _ := strings.Cut(path, "/")
+	return section
+}
+
+// defaultRateLimit returns the budget per second used until Bybit reports the actual one
+func defaultRateLimit(group string) int {
+	switch {
+	case strings.HasPrefix(group, "order/") && strings.HasSuffix(group, ":spot"):
+		return 20
+	case strings.HasPrefix(group, "order/"):
+		return 10
+	case group == "order", group == "position", group == "execution", group == "account":
+		return 10
+	case group == "market":
+		// public endpoints are limited per ip, 600 requests in 5 seconds, and return no X-Bapi-Limit
+		return 120
+	default:
+		return 5
+	}
+}
+
+// Budget returns the current budget of the group
+func (l *RateLimiter) Budget(group string) RateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.budget(group, l.bucket(group))
+}
+
+// Budgets returns the current budget of every group seen so far
+func (l *RateLimiter) Budgets() []RateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	budgets := make([]RateLimitBudget, 0, len(l.buckets))
+	for group, b := range l.buckets {
+		budgets = append(budgets, l.budget(group, b))
+	}
+	return budgets
+}
+
+func (l *RateLimiter) budget(group string, b *tokenBucket) RateLimitBudget {
+	now := time.Now()
+	b.refill(now)
+	budget := RateLimitBudget{
+		Group:     group,
+		Limit:     int(b.limit),
+		Remaining: int(b.tokens),
+	}
+	if now.Before(b.blockedUntil) {
+		budget.Remaining = 0
+		budget.ResetAt = b.blockedUntil
+	}
+	return budget
+}
+
+func (l *RateLimiter) bucket(group string) *tokenBucket {
+	b, ok := l.buckets[group]
+	if !ok {
+		limit := float64(defaultRateLimit(group))
+		b = &tokenBucket{
+			limit:     limit,
+			tokens:    limit,
+			updatedAt: time.Now(),
+		}
+		l.buckets[group] = b
+	}
+	return b
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = min(b.limit, b.tokens+elapsed.Seconds()*b.limit)
+		b.updatedAt = now
+	}
+}
+
+// take consumes a token, or returns how long to wait for one
+func (l *RateLimiter) take(group string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	b := l.bucket(group)
+	if now.Before(b.blockedUntil) {
+		return b.blockedUntil.Sub(now)
+	}
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.limit * float64(time.Second))
+}
+
+func (l *RateLimiter) wait(ctx context.Context, group string) error {
+	for {
+		delay := l.take(group)
+		if delay <= 0 {
+			return nil
+		}
+		if l.failFast {
+			return fmt.Errorf("%w: %s, retry after %v", ErrRateLimitExceeded, group, delay)
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *RateLimiter) update(group string, header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-Bapi-Limit"))
+	if err != nil || limit <= 0 {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get("X-Bapi-Limit-Status"))
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(group)
+	b.refill(time.Now())
+	if !b.reported || float64(limit) < b.limit {
+		b.limit = float64(limit)
+		b.reported = true
+	}
+	b.tokens = min(b.tokens, float64(remaining))
+	if remaining <= 0 {
+		b.blockedUntil = parseRateLimitReset(header)
+	}
+}
+
+func categoryFromBody(body []byte) CategoryV5 {
+	var param struct {
+		Category CategoryV5 `json:"category"`
+	}
+	if err := json.Unmarshal(body, &param); err != nil {
+		return ""
+	}
+	return param.Category
+}
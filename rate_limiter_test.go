@@ -0,0 +1,159 @@
+package bybit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitGroup(t *testing.T) {
+	tests := []struct {
+		path     string
+		category CategoryV5
+		want     string
+	}{
+		{path: "/v5/order/create", category: CategoryV5Linear, want: "order/create:linear"},
+		{path: "/v5/order/cancel-all", category: CategoryV5Spot, want: "order/cancel-all:spot"},
+		{path: "/v5/order/realtime", category: CategoryV5Linear, want: "order"},
+		{path: "/v5/position/set-leverage", category: CategoryV5Linear, want: "position"},
+		{path: "/v5/account/wallet-balance", want: "account"},
+		{path: "/v5/asset/transfer/inter-transfer", want: "asset"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, RateLimitGroup(tt.path, tt.category), tt.path)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("default budget", func(t *testing.T) {
+		limiter := NewRateLimiter().WithFailFast(true)
+
+		for i := 0; i < 20; i++ {
+			require.NoError(t, limiter.wait(context.Background(), "order/create:spot"))
+		}
+		assert.ErrorIs(t, limiter.wait(context.Background(), "order/create:spot"), ErrRateLimitExceeded)
+		assert.Equal(t, 10, limiter.Budget("order/create:linear").Remaining)
+	})
+	t.Run("follows headers", func(t *testing.T) {
+		limiter := NewRateLimiter().WithFailFast(true)
+		resetAt := time.Now().Add(time.Minute).Truncate(time.Millisecond)
+
+		header := http.Header{}
+		header.Set("X-Bapi-Limit", "50")
+		header.Set("X-Bapi-Limit-Status", "0")
+		header.Set("X-Bapi-Limit-Reset-Timestamp", strconv.FormatInt(resetAt.UnixMilli(), 10))
+		limiter.update("position", header)
+
+		budget := limiter.Budget("position")
+		assert.Equal(t, 50, budget.Limit)
+		assert.Equal(t, 0, budget.Remaining)
+		assert.True(t, resetAt.Equal(budget.ResetAt))
+		assert.ErrorIs(t, limiter.wait(context.Background(), "position"), ErrRateLimitExceeded)
+	})
+	t.Run("keeps the lowest limit of the group", func(t *testing.T) {
+		limitHeader := func(limit, remaining string) http.Header {
+			header := http.Header{}
+			header.Set("X-Bapi-Limit", limit)
+			header.Set("X-Bapi-Limit-Status", remaining)
+			return header
+		}
+		var listCalls, closedPnLCalls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/position/list", &listCalls,
+				respondJSON(t, http.StatusOK, limitHeader("10", "9"), map[string]interface{}{"retCode": 0}),
+			),
+			withSequenceHandlerOption("/v5/position/closed-pnl", &closedPnLCalls,
+				respondJSON(t, http.StatusOK, limitHeader("50", "49"), map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		limiter := NewRateLimiter()
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithRateLimiter(limiter)
+
+		_, err := client.V5().Position().GetPositionInfo(V5GetPositionInfoParam{Category: CategoryV5Linear})
+		require.NoError(t, err)
+		assert.Equal(t, 10, limiter.Budget("position").Limit)
+
+		// a 50/s endpoint of the same section must not raise the budget of the 10/s one
+		_, err = client.V5().Position().GetClosedPnL(V5GetClosedPnLParam{Category: CategoryV5Linear})
+		require.NoError(t, err)
+		assert.Equal(t, 10, limiter.Budget("position").Limit)
+		assert.LessOrEqual(t, limiter.Budget("position").Remaining, 9)
+
+		// the first reported limit replaces the default even when it is higher
+		limiter.update("asset", limitHeader("20", "19"))
+		assert.Equal(t, 20, limiter.Budget("asset").Limit)
+	})
+	t.Run("signs after waiting", func(t *testing.T) {
+		server, teardown := testhelper.NewServer(func(mux *http.ServeMux) {
+			mux.HandleFunc("/v5/position/list", func(w http.ResponseWriter, r *http.Request) {
+				ts, _ := strconv.ParseInt(r.Header.Get("X-BAPI-TIMESTAMP"), 10, 64)
+				recvWindow, _ := strconv.ParseInt(r.Header.Get("X-BAPI-RECV-WINDOW"), 10, 64)
+				body := map[string]interface{}{"retCode": 0}
+				if time.Now().UnixMilli()-ts > recvWindow {
+					body = map[string]interface{}{"retCode": 10002, "retMsg": "invalid request, please check your server timestamp or recv_window param"}
+				}
+				respondJSON(t, http.StatusOK, nil, body)(w, r)
+			})
+		})
+		defer teardown()
+
+		limiter := NewRateLimiter()
+		header := http.Header{}
+		header.Set("X-Bapi-Limit", "10")
+		header.Set("X-Bapi-Limit-Status", "0")
+		header.Set("X-Bapi-Limit-Reset-Timestamp", strconv.FormatInt(time.Now().Add(300*time.Millisecond).UnixMilli(), 10))
+		limiter.update("position", header)
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithRecvWindow(100 * time.Millisecond).
+			WithRateLimiter(limiter)
+
+		start := time.Now()
+		_, err := client.V5().Position().GetPositionInfo(V5GetPositionInfoParam{Category: CategoryV5Linear})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	})
+	t.Run("blocks until refill", func(t *testing.T) {
+		var calls int32
+		header := http.Header{}
+		header.Set("X-Bapi-Limit", "50")
+		header.Set("X-Bapi-Limit-Status", "0")
+		header.Set("X-Bapi-Limit-Reset-Timestamp", strconv.FormatInt(time.Now().Add(30*time.Millisecond).UnixMilli(), 10))
+
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/position/list", &calls,
+				respondJSON(t, http.StatusOK, header, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithRateLimiter(NewRateLimiter())
+
+		param := V5GetPositionInfoParam{Category: CategoryV5Linear}
+		_, err := client.V5().Position().GetPositionInfo(param)
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = client.V5().Position().GetPositionInfo(param)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+}
@@ -0,0 +1,56 @@
+package bybit
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRecvWindow : recv_window of signed V5 REST requests
+	DefaultRecvWindow = 5 * time.Second
+	// DefaultWebsocketTradeRecvWindow : recv_window of V5 WebSocket trade requests
+	DefaultWebsocketTradeRecvWindow = 8 * time.Second
+)
+
+// WithRecvWindow : how long after the request timestamp Bybit still accepts a signed V5 request
+func (c *Client) WithRecvWindow(recvWindow time.Duration) *Client {
+	c.recvWindow = recvWindow
+
+	return c
+}
+
+// WithRecvWindow : recv_window sent with V5 WebSocket trade requests
+func (c *WebSocketClient) WithRecvWindow(recvWindow time.Duration) *WebSocketClient {
+	c.recvWindow = recvWindow
+
+	return c
+}
+
+type recvWindowContextKey struct{}
+
+// ContextWithRecvWindow overrides the client recv_window for a single V5 ...WithContext call
+func ContextWithRecvWindow(ctx context.Context, recvWindow time.Duration) context.Context {
+	return context.WithValue(ctx, recvWindowContextKey{}, recvWindow)
+}
+
+func (c *Client) recvWindowFor(ctx context.Context) string {
+	if recvWindow, ok := ctx.Value(recvWindowContextKey{}).(time.Duration); ok && recvWindow > 0 {
+		return formatRecvWindow(recvWindow)
+	}
+	if c.recvWindow > 0 {
+		return formatRecvWindow(c.recvWindow)
+	}
+	return formatRecvWindow(DefaultRecvWindow)
+}
+
+func (c *WebSocketClient) tradeRecvWindow() string {
+	if c.recvWindow > 0 {
+		return formatRecvWindow(c.recvWindow)
+	}
+	return formatRecvWindow(DefaultWebsocketTradeRecvWindow)
+}
+
+func formatRecvWindow(recvWindow time.Duration) string {
+	return strconv.FormatInt(recvWindow.Milliseconds(), 10)
+}
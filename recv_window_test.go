@@ -0,0 +1,67 @@
+package bybit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RecvWindow(t *testing.T) {
+	var captured *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"retCode":0}`))
+	}))
+	defer server.Close()
+
+	param := V5GetPositionInfoParam{Category: CategoryV5Linear}
+	verify := func(t *testing.T, wantRecvWindow string) {
+		require.NotNil(t, captured)
+		assert.Equal(t, wantRecvWindow, captured.Header.Get("X-BAPI-RECV-WINDOW"))
+
+		payload := captured.Header.Get("X-BAPI-TIMESTAMP") + "test" + wantRecvWindow + captured.URL.Query().Encode()
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(payload))
+		assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), captured.Header.Get("X-BAPI-SIGN"))
+	}
+
+	t.Run("default", func(t *testing.T) {
+		client := NewClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "secret")
+
+		_, err := client.V5().Position().GetPositionInfo(param)
+		require.NoError(t, err)
+		verify(t, "5000")
+	})
+	t.Run("client option", func(t *testing.T) {
+		client := NewClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "secret").
+			WithRecvWindow(20 * time.Second)
+
+		_, err := client.V5().Position().GetPositionInfo(param)
+		require.NoError(t, err)
+		verify(t, "20000")
+	})
+	t.Run("per call override", func(t *testing.T) {
+		client := NewClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "secret").
+			WithRecvWindow(20 * time.Second)
+
+		ctx := ContextWithRecvWindow(context.Background(), 1500*time.Millisecond)
+		_, err := client.V5().Position().GetPositionInfoWithContext(ctx, param)
+		require.NoError(t, err)
+		verify(t, "1500")
+	})
+}
@@ -41,8 +41,9 @@ func checkV3ResponseBody(body []byte) error {
 	switch {
 	case commonResponse.RetCode != 0:
 		return &ErrorResponse{
-			RetCode: commonResponse.RetCode,
-			RetMsg:  commonResponse.RetMsg,
+			RetCode:    commonResponse.RetCode,
+			RetMsg:     commonResponse.RetMsg,
+			RetExtInfo: commonResponse.RetExtInfo,
 		}
 	default:
 		return nil
@@ -65,8 +66,9 @@ func checkV5ResponseBody(body []byte) error {
 
 	case commonResponse.RetCode != 0:
 		return &ErrorResponse{
-			RetCode: commonResponse.RetCode,
-			RetMsg:  commonResponse.RetMsg,
+			RetCode:    commonResponse.RetCode,
+			RetMsg:     commonResponse.RetMsg,
+			RetExtInfo: commonResponse.RetExtInfo,
 		}
 	default:
 		return nil
@@ -99,12 +101,18 @@ type CommonV5Response struct {
 	RetMsg     string      `json:"retMsg"`
 	RetExtInfo interface{} `json:"retExtInfo"`
 	Time       int         `json:"time"`
+
+	// Metadata is set when Client.WithResponseMetadata is enabled
+	Metadata *ResponseMetadata `json:"-"`
 }
 
 // ErrorResponse :
+// Use errors.Is with a RetCodeClass, e.g. ErrInsufficientBalance, instead of comparing RetCode.
 type ErrorResponse struct {
 	RetCode int    `json:"ret_code"`
 	RetMsg  string `json:"ret_msg"`
+	// RetExtInfo is the retExtInfo detail of V3 and V5 responses
+	RetExtInfo interface{} `json:"ret_ext_info,omitempty"`
 }
 
 // Error :
@@ -123,6 +131,9 @@ func (r *RateLimitError) Error() string {
 
 type RateLimitV5Error struct {
 	*CommonV5Response `json:",inline"`
+
+	// ResetAt is taken from X-Bapi-Limit-Reset-Timestamp, zero when absent
+	ResetAt time.Time `json:"-"`
 }
 
 func (r *RateLimitV5Error) Error() string {
@@ -148,4 +159,7 @@ var (
 	// 1. Wrong path;
 	// 2. Category value does not match account mode
 	ErrPathNotFound = errors.New("path not found")
+	// ErrServerError :
+	// Bybit responded with 5xx, the request may or may not have been processed
+	ErrServerError = errors.New("server error")
 )
@@ -0,0 +1,95 @@
+package bybit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseMetadata : transport level details of a REST call
+type ResponseMetadata struct {
+	StatusCode int
+	Header     http.Header
+	// TraceID is the Traceid header, quote it when contacting Bybit support
+	TraceID string
+	// ServerTime is taken from the Timenow header
+	ServerTime time.Time
+	RateLimit  RateLimitStatus
+	// Latency is the round trip of the last attempt, including reading the body
+	Latency time.Duration
+	// Attempts counts the requests sent, greater than 1 when the retry policy kicked in
+	Attempts int
+}
+
+// RateLimitStatus : X-Bapi-Limit, X-Bapi-Limit-Status and X-Bapi-Limit-Reset-Timestamp
+// Only private endpoints return them, zero otherwise.
+type RateLimitStatus struct {
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+}
+
+type responseMetadataContextKey struct{}
+
+// ContextWithResponseMetadata returns a context that makes a V5 ...WithContext call fill dst,
+// also when the call returns an error after receiving a response.
+func ContextWithResponseMetadata(ctx context.Context, dst *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataContextKey{}, dst)
+}
+
+// WithResponseMetadata : fill CommonV5Response.Metadata of every V5 response
+func (c *Client) WithResponseMetadata(enabled bool) *Client {
+	c.responseMetadata = enabled
+
+	return c
+}
+
+type responseMetadataSetter interface {
+	setResponseMetadata(*ResponseMetadata)
+}
+
+func (r *CommonV5Response) setResponseMetadata(m *ResponseMetadata) {
+	r.Metadata = m
+}
+
+func (c *Client) reportResponseMetadata(
+	ctx context.Context,
+	resp *http.Response,
+	latency time.Duration,
+	attempts int,
+	dst interface{},
+) {
+	ctxDst, _ := ctx.Value(responseMetadataContextKey{}).(*ResponseMetadata)
+	setter, _ := dst.(responseMetadataSetter)
+	if ctxDst == nil && (!c.responseMetadata || setter == nil) {
+		return
+	}
+
+	m := newResponseMetadata(resp, latency, attempts)
+	if ctxDst != nil {
+		*ctxDst = *m
+	}
+	if c.responseMetadata && setter != nil {
+		setter.setResponseMetadata(m)
+	}
+}
+
+func newResponseMetadata(resp *http.Response, latency time.Duration, attempts int) *ResponseMetadata {
+	m := &ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		TraceID:    resp.Header.Get("Traceid"),
+		Latency:    latency,
+		Attempts:   attempts,
+	}
+	if ms, err := strconv.ParseInt(resp.Header.Get("Timenow"), 10, 64); err == nil {
+		m.ServerTime = time.UnixMilli(ms)
+	}
+	if limit, err := strconv.Atoi(resp.Header.Get("X-Bapi-Limit")); err == nil {
+		m.RateLimit.Limit = limit
+		m.RateLimit.Remaining, _ = strconv.Atoi(resp.Header.Get("X-Bapi-Limit-Status"))
+		m.RateLimit.ResetAt = parseRateLimitReset(resp.Header)
+	}
+	return m
+}
@@ -0,0 +1,89 @@
+package bybit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseMetadata(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	header := http.Header{}
+	header.Set("Traceid", "c4b7e2f2f1f4a3b1")
+	header.Set("Timenow", strconv.FormatInt(now.UnixMilli(), 10))
+	header.Set("X-Bapi-Limit", "20")
+	header.Set("X-Bapi-Limit-Status", "19")
+	header.Set("X-Bapi-Limit-Reset-Timestamp", strconv.FormatInt(now.Add(time.Second).UnixMilli(), 10))
+
+	param := V5GetPositionInfoParam{Category: CategoryV5Linear}
+
+	t.Run("client option", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/position/list", &calls,
+				respondJSON(t, http.StatusOK, header, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithResponseMetadata(true)
+
+		resp, err := client.V5().Position().GetPositionInfo(param)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Metadata)
+
+		assert.Equal(t, http.StatusOK, resp.Metadata.StatusCode)
+		assert.Equal(t, "c4b7e2f2f1f4a3b1", resp.Metadata.TraceID)
+		assert.True(t, now.Equal(resp.Metadata.ServerTime))
+		assert.Equal(t, RateLimitStatus{Limit: 20, Remaining: 19, ResetAt: now.Add(time.Second)}, resp.Metadata.RateLimit)
+		assert.Equal(t, 1, resp.Metadata.Attempts)
+		assert.Greater(t, resp.Metadata.Latency, time.Duration(0))
+	})
+	t.Run("disabled by default", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/position/list", &calls,
+				respondJSON(t, http.StatusOK, header, map[string]interface{}{"retCode": 0}),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test")
+
+		resp, err := client.V5().Position().GetPositionInfo(param)
+		require.NoError(t, err)
+		assert.Nil(t, resp.Metadata)
+	})
+	t.Run("context option on error", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/position/list", &calls,
+				respondJSON(t, http.StatusOK, header, map[string]interface{}{"retCode": 10001, "retMsg": "params error"}),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test")
+
+		var meta ResponseMetadata
+		ctx := ContextWithResponseMetadata(context.Background(), &meta)
+
+		_, err := client.V5().Position().GetPositionInfoWithContext(ctx, param)
+		require.Error(t, err)
+		assert.Equal(t, "c4b7e2f2f1f4a3b1", meta.TraceID)
+		assert.Equal(t, 19, meta.RateLimit.Remaining)
+	})
+}
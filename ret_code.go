@@ -0,0 +1,127 @@
+package bybit
+
+import (
+	"errors"
+)
+
+// RetCodeClass : a class of V5 retCodes, matched by *ErrorResponse with errors.Is
+//
+//	if errors.Is(err, bybit.ErrInsufficientBalance) {
+//		...
+//	}
+type RetCodeClass struct {
+	name      string
+	retCodes  []int
+	retryable bool
+}
+
+func newRetCodeClass(name string, retryable bool, retCodes ...int) *RetCodeClass {
+	return &RetCodeClass{name: name, retCodes: retCodes, retryable: retryable}
+}
+
+// Error :
+func (c *RetCodeClass) Error() string {
+	return c.name
+}
+
+// Retryable : whether sending the same request again can succeed
+func (c *RetCodeClass) Retryable() bool {
+	return c.retryable
+}
+
+// RetCodes : the retCodes of the class
+func (c *RetCodeClass) RetCodes() []int {
+	return append([]int(nil), c.retCodes...)
+}
+
+func (c *RetCodeClass) has(retCode int) bool {
+	for _, code := range c.retCodes {
+		if code == retCode {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ErrInsufficientBalance :
+	ErrInsufficientBalance = newRetCodeClass("insufficient balance", false, 110004, 110007, 110012, 110044, 110045, 170131)
+	// ErrOrderNotFound : the order does not exist or is already filled or cancelled
+	ErrOrderNotFound = newRetCodeClass("order not found or already finished", false, 110001, 110008, 110010, 170213)
+	// ErrInvalidPrecision : qty or price has too many decimals
+	ErrInvalidPrecision = newRetCodeClass("invalid qty or price precision", false, 170134, 170135, 170137)
+	// ErrOrderLimit : qty or order value is outside the limits of the instrument
+	ErrOrderLimit = newRetCodeClass("qty or order value out of instrument limits", false, 110094, 170136, 170140)
+	// ErrPositionModeMismatch : the position mode or positionIdx does not fit the request
+	ErrPositionModeMismatch = newRetCodeClass("position mode mismatch", false, 110024, 110025, 110028, 110029)
+	// ErrLeverageNotModified :
+	ErrLeverageNotModified = newRetCodeClass("leverage not modified", false, 110043)
+	// ErrReduceOnlyViolation : the order would increase the position, e.g. reduce-only or "can only reduce position"
+	ErrReduceOnlyViolation = newRetCodeClass("reduce-only rule not satisfied", false, 110017, 110023)
+	// ErrRiskLimitExceeded :
+	ErrRiskLimitExceeded = newRetCodeClass("risk limit exceeded", false, 110016, 110090)
+	// ErrTimestamp : the timestamp is outside of recv_window, retryable after syncing the server time
+	ErrTimestamp = newRetCodeClass("invalid timestamp or recv_window", true, 10002)
+	// ErrPermissionDenied : the api key lacks the permission
+	ErrPermissionDenied = newRetCodeClass("permission denied", false, 10005)
+	// ErrIPNotWhitelisted :
+	ErrIPNotWhitelisted = newRetCodeClass("ip not whitelisted", false, 10010)
+	// ErrServerBusy : Bybit failed to process the request in time
+	ErrServerBusy = newRetCodeClass("server busy", true, 10016)
+)
+
+var retCodeClasses = []*RetCodeClass{
+	ErrInsufficientBalance,
+	ErrOrderNotFound,
+	ErrInvalidPrecision,
+	ErrOrderLimit,
+	ErrPositionModeMismatch,
+	ErrLeverageNotModified,
+	ErrReduceOnlyViolation,
+	ErrRiskLimitExceeded,
+	ErrTimestamp,
+	ErrPermissionDenied,
+	ErrIPNotWhitelisted,
+	ErrServerBusy,
+}
+
+// Is : matches the RetCodeClass of the retCode
+func (r *ErrorResponse) Is(target error) bool {
+	class, ok := target.(*RetCodeClass)
+	return ok && class.has(r.RetCode)
+}
+
+// Class : the RetCodeClass of the retCode, nil when unclassified
+func (r *ErrorResponse) Class() *RetCodeClass {
+	for _, class := range retCodeClasses {
+		if class.has(r.RetCode) {
+			return class
+		}
+	}
+	return nil
+}
+
+// Retryable : whether sending the same request again can succeed
+func (r *ErrorResponse) Retryable() bool {
+	class := r.Class()
+	return class != nil && class.Retryable()
+}
+
+// IsRetryable : reports whether the error of a REST call is transient
+// Rate limit errors, retryable retCodes and 5xx responses are transient,
+// network errors are not reported since it depends on whether the request is idempotent.
+func IsRetryable(err error) bool {
+	var (
+		errResp        *ErrorResponse
+		rateLimitErr   *RateLimitError
+		rateLimitV5Err *RateLimitV5Error
+	)
+	switch {
+	case errors.As(err, &rateLimitV5Err), errors.As(err, &rateLimitErr):
+		return true
+	case errors.As(err, &errResp):
+		return errResp.Retryable()
+	default:
+		return errors.Is(err, ErrServerError)
+	}
+}
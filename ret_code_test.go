@@ -0,0 +1,93 @@
+package bybit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponse_Is(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/order/create", &calls,
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+				"retCode":    110007,
+				"retMsg":     "ab not enough for new order",
+				"retExtInfo": map[string]interface{}{"detail": "available 0"},
+			}),
+		),
+	)
+	defer teardown()
+
+	client := NewTestClient().
+		WithBaseURL(server.URL).
+		WithAuth("test", "test")
+
+	_, err := client.V5().Order().CreateOrder(V5CreateOrderParam{
+		Category:  CategoryV5Linear,
+		Symbol:    SymbolV5BTCUSDT,
+		Side:      SideBuy,
+		OrderType: OrderTypeMarket,
+		Qty:       "0.01",
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInsufficientBalance))
+	assert.False(t, errors.Is(err, ErrOrderNotFound))
+	assert.False(t, IsRetryable(err))
+
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	assert.Equal(t, 110007, errResp.RetCode)
+	assert.Same(t, ErrInsufficientBalance, errResp.Class())
+	assert.Equal(t, map[string]interface{}{"detail": "available 0"}, errResp.RetExtInfo)
+}
+
+func TestRetCodeClass(t *testing.T) {
+	tests := []struct {
+		retCode   int
+		class     *RetCodeClass
+		retryable bool
+	}{
+		{retCode: 110001, class: ErrOrderNotFound},
+		{retCode: 170135, class: ErrInvalidPrecision},
+		{retCode: 110094, class: ErrOrderLimit},
+		{retCode: 110024, class: ErrPositionModeMismatch},
+		{retCode: 110025, class: ErrPositionModeMismatch},
+		{retCode: 110028, class: ErrPositionModeMismatch},
+		{retCode: 110029, class: ErrPositionModeMismatch},
+		{retCode: 110043, class: ErrLeverageNotModified},
+		{retCode: 110017, class: ErrReduceOnlyViolation},
+		{retCode: 110023, class: ErrReduceOnlyViolation},
+		{retCode: 110016, class: ErrRiskLimitExceeded},
+		{retCode: 110090, class: ErrRiskLimitExceeded},
+		{retCode: 10002, class: ErrTimestamp, retryable: true},
+		{retCode: 10005, class: ErrPermissionDenied},
+		{retCode: 10010, class: ErrIPNotWhitelisted},
+		{retCode: 10016, class: ErrServerBusy, retryable: true},
+		{retCode: 10001},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.retCode), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", &ErrorResponse{RetCode: tt.retCode})
+			if tt.class != nil {
+				assert.ErrorIs(t, err, tt.class)
+			}
+			assert.Equal(t, tt.retryable, IsRetryable(err))
+
+			var errResp *ErrorResponse
+			require.True(t, errors.As(err, &errResp))
+			assert.Equal(t, tt.class, errResp.Class())
+		})
+	}
+
+	t.Run("rate limit and server error", func(t *testing.T) {
+		assert.True(t, IsRetryable(&RateLimitV5Error{CommonV5Response: &CommonV5Response{RetCode: 10006}}))
+		assert.True(t, IsRetryable(fmt.Errorf("%w: status code 502", ErrServerError)))
+		assert.False(t, IsRetryable(ErrPathNotFound))
+	})
+}
@@ -0,0 +1,197 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy :
+// Retries are opt-in, see Client.WithRetryPolicy.
+// Rate limit (10006, 10018) and timestamp (10002) errors are always retried because
+// Bybit rejected the request before processing it.
+// Network errors, 5xx responses and server busy (10016) errors are retried only for GET requests and for POST
+// requests carrying an orderLinkId or transferId, which makes the retry safe.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry, doubled on every retry
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff, it does not cap the wait for a rate limit reset
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy :
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  200 * time.Millisecond,
+		MaxDelay:   5 * time.Second,
+	}
+}
+
+// WithRetryPolicy :
+func (c *Client) WithRetryPolicy(policy RetryPolicy) *Client {
+	c.retryPolicy = &policy
+
+	return c
+}
+
+// backoff returns the full jitter delay for the given retry, starting from 0
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay << retry
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return rand.N(delay + 1)
+}
+
+type requestTarget struct {
+	endpoint Endpoint
+	// idempotent is true when a network error or 5xx can be retried safely
+	idempotent bool
+	// signed is true when the request carries a V5 signature
+	signed bool
+	// rateLimitGroup is the RateLimiter bucket, empty when not limited
+	rateLimitGroup string
+}
+
+// doRequest sends the request built by newRequest, applying the rate limiter, the failover and the retry policy
+func (c *Client) doRequest(
+	ctx context.Context,
+	newRequest func() (*http.Request, error),
+	target requestTarget,
+	dst interface{},
+) error {
+	retry, failovers := 0, 0
+	for attempt := 1; ; attempt++ {
+		// wait before signing, the wait can outlast recv_window
+		if c.rateLimiter != nil && target.rateLimitGroup != "" {
+			if err := c.rateLimiter.wait(ctx, target.rateLimitGroup); err != nil {
+				return err
+			}
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		call := &Call{
+			Endpoint: target.endpoint,
+			Request:  req,
+			Response: dst,
+			Attempt:  attempt,
+		}
+		err = c.send(ctx, call)
+		if resp := call.HTTPResponse; resp != nil {
+			if c.rateLimiter != nil && target.rateLimitGroup != "" {
+				c.rateLimiter.update(target.rateLimitGroup, resp.Header)
+			}
+			c.reportResponseMetadata(ctx, resp, time.Since(start), attempt, dst)
+		}
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		if c.failover != nil && isFailoverError(err) {
+			c.failover.reportFailure(baseURLOf(req.URL), err)
+			if target.idempotent && failovers < c.failover.size()-1 {
+				failovers++
+				c.log().LogAttrs(ctx, slog.LevelWarn, "failover",
+					slog.String("from", baseURLOf(req.URL)),
+					slog.String("to", c.failover.BaseURL()),
+					errorAttr("error", err),
+				)
+				continue
+			}
+		}
+
+		if c.retryPolicy == nil || retry >= c.retryPolicy.MaxRetries {
+			return err
+		}
+
+		delay := c.retryPolicy.backoff(retry)
+		var (
+			rateLimitErr *RateLimitV5Error
+			errResp      *ErrorResponse
+		)
+		switch {
+		case errors.As(err, &rateLimitErr):
+			if wait := time.Until(rateLimitErr.ResetAt); wait > delay {
+				delay = wait
+			}
+		case target.signed && errors.As(err, &errResp) && errors.Is(errResp, ErrTimestamp):
+			if err := c.SyncServerTimeWithContext(ctx); err != nil {
+				return errors.Join(errResp, err)
+			}
+			delay = 0
+		case target.idempotent && (isNetworkError(err) || errors.Is(err, ErrServerError) || errors.Is(err, ErrServerBusy)):
+		default:
+			return err
+		}
+		retry++
+
+		c.log().LogAttrs(ctx, slog.LevelInfo, "retry",
+			slog.Int("attempt", attempt+1),
+			slog.Duration("delay", delay),
+			errorAttr("error", err),
+		)
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+func isNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// hasIdempotencyKey reports whether Bybit can deduplicate the request body
+func hasIdempotencyKey(body []byte) bool {
+	var keys struct {
+		OrderLinkID string `json:"orderLinkId"`
+		TransferID  string `json:"transferId"`
+	}
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return false
+	}
+	return keys.OrderLinkID != "" || keys.TransferID != ""
+}
+
+func parseRateLimitReset(header http.Header) time.Time {
+	ms, err := strconv.ParseInt(header.Get("X-Bapi-Limit-Reset-Timestamp"), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
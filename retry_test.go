@@ -0,0 +1,205 @@
+package bybit
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withSequenceHandlerOption(
+	path string,
+	calls *int32,
+	handlers ...http.HandlerFunc,
+) func(*http.ServeMux) {
+	return func(mux *http.ServeMux) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			i := int(atomic.AddInt32(calls, 1)) - 1
+			if i >= len(handlers) {
+				i = len(handlers) - 1
+			}
+			w.Header().Set("Content-Type", "application/json")
+			handlers[i](w, r)
+		})
+	}
+}
+
+func respondJSON(t *testing.T, status int, header http.Header, body interface{}) http.HandlerFunc {
+	bytesBody, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(bytesBody)
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 2,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   10 * time.Millisecond,
+	}
+	okBody := map[string]interface{}{
+		"retCode": 0,
+		"result": map[string]interface{}{
+			"orderId":     "1358868270414852352",
+			"orderLinkId": "link",
+		},
+	}
+	price := "10000.0"
+	param := V5CreateOrderParam{
+		Category:  CategoryV5Spot,
+		Symbol:    SymbolV5BTCUSDT,
+		Side:      SideBuy,
+		OrderType: OrderTypeLimit,
+		Qty:       "0.01",
+		Price:     &price,
+	}
+
+	t.Run("rate limit is retried after reset", func(t *testing.T) {
+		var calls int32
+		reset := http.Header{}
+		reset.Set("X-Bapi-Limit-Reset-Timestamp", strconv.FormatInt(time.Now().Add(20*time.Millisecond).UnixMilli(), 10))
+
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &calls,
+				respondJSON(t, http.StatusOK, reset, map[string]interface{}{"retCode": 10006, "retMsg": "Too many visits!"}),
+				respondJSON(t, http.StatusOK, nil, okBody),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithRetryPolicy(policy)
+
+		start := time.Now()
+		resp, err := client.V5().Order().CreateOrder(param)
+		require.NoError(t, err)
+		assert.Equal(t, "1358868270414852352", resp.Result.OrderID)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+		assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+	})
+	t.Run("timestamp error syncs server time", func(t *testing.T) {
+		var calls, timeCalls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &calls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{"retCode": 10002, "retMsg": "invalid request, please check your server timestamp or recv_window param"}),
+				respondJSON(t, http.StatusOK, nil, okBody),
+			),
+			withSequenceHandlerOption("/v5/market/time", &timeCalls,
+				respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+					"retCode": 0,
+					"result": map[string]interface{}{
+						"timeSecond": strconv.FormatInt(time.Now().Unix(), 10),
+						"timeNano":   strconv.FormatInt(time.Now().UnixNano(), 10),
+					},
+				}),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithRetryPolicy(policy)
+
+		_, err := client.V5().Order().CreateOrder(param)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&timeCalls))
+	})
+	t.Run("server error without orderLinkId is not retried", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &calls,
+				respondJSON(t, http.StatusBadGateway, nil, nil),
+				respondJSON(t, http.StatusOK, nil, okBody),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithRetryPolicy(policy)
+
+		_, err := client.V5().Order().CreateOrder(param)
+		assert.ErrorIs(t, err, ErrServerError)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+	t.Run("server error with orderLinkId is retried", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/order/create", &calls,
+				respondJSON(t, http.StatusBadGateway, nil, nil),
+				respondJSON(t, http.StatusOK, nil, okBody),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test").
+			WithRetryPolicy(policy)
+
+		linkedParam := param
+		linkedParam.OrderLinkID = testhelper.Ptr("link")
+
+		_, err := client.V5().Order().CreateOrder(linkedParam)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+	t.Run("gives up after max retries", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/market/kline", &calls,
+				respondJSON(t, http.StatusServiceUnavailable, nil, nil),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithRetryPolicy(policy)
+
+		_, err := client.V5().Market().GetKline(V5GetKlineParam{
+			Category: CategoryV5Spot,
+			Symbol:   SymbolV5BTCUSDT,
+			Interval: IntervalD,
+		})
+		assert.ErrorIs(t, err, ErrServerError)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+	t.Run("no policy", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(
+			withSequenceHandlerOption("/v5/market/kline", &calls,
+				respondJSON(t, http.StatusServiceUnavailable, nil, nil),
+			),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL)
+
+		_, err := client.V5().Market().GetKline(V5GetKlineParam{
+			Category: CategoryV5Spot,
+			Symbol:   SymbolV5BTCUSDT,
+			Interval: IntervalD,
+		})
+		assert.ErrorIs(t, err, ErrServerError)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
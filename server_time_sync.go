@@ -0,0 +1,108 @@
+package bybit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ServerTimeStatus : estimate of the local clock against the Bybit server clock
+type ServerTimeStatus struct {
+	// Offset is the local clock minus the server clock, subtracted from the timestamp of signed requests
+	Offset time.Duration
+	// ErrorBound is half the round trip of the sync request, the true offset lies within Offset ± ErrorBound
+	ErrorBound time.Duration
+	// Drift is the change of Offset since the previous sync
+	Drift    time.Duration
+	SyncedAt time.Time
+}
+
+type serverClock struct {
+	mu      sync.RWMutex
+	current ServerTimeStatus
+}
+
+func (c *serverClock) set(offset, errorBound time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.current.SyncedAt.IsZero() {
+		c.current.Drift = offset - c.current.Offset
+	}
+	c.current.Offset = offset
+	c.current.ErrorBound = errorBound
+	c.current.SyncedAt = time.Now()
+}
+
+func (c *serverClock) status() ServerTimeStatus {
+	if c == nil {
+		return ServerTimeStatus{}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.current
+}
+
+// ServerTimeStatus returns the result of the last server time sync, zero before the first one
+func (c *Client) ServerTimeStatus() ServerTimeStatus {
+	return c.clock.status()
+}
+
+// SyncServerTimeWithContext measures the offset against /v5/market/time.
+// The server time is compared with the midpoint of the request to compensate for the round trip.
+func (c *Client) SyncServerTimeWithContext(ctx context.Context) error {
+	service := &V5MarketService{c.withCheckResponseBody(checkV5ResponseBody)}
+
+	sentAt := time.Now()
+	r, err := service.GetServerTimeWithContext(ctx)
+	receivedAt := time.Now()
+	if err != nil {
+		return fmt.Errorf("get server time: %w", err)
+	}
+
+	if r.Result.TimeNano == "" {
+		return errors.New("server time is empty")
+	}
+	serverTimeNS, err := strconv.ParseInt(r.Result.TimeNano, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse server time: %w", err)
+	}
+
+	roundTrip := receivedAt.Sub(sentAt)
+	midpoint := sentAt.Add(roundTrip / 2)
+	c.clock.set(midpoint.Sub(time.Unix(0, serverTimeNS)), roundTrip/2)
+	return nil
+}
+
+// StartServerTimeSync syncs the server time once and then keeps re-syncing on interval until ctx is done.
+// Errors of the background syncs are passed to errHandler when given, the last good offset is kept.
+func (c *Client) StartServerTimeSync(ctx context.Context, interval time.Duration, errHandler func(error)) error {
+	if interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if err := c.SyncServerTimeWithContext(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.SyncServerTimeWithContext(ctx); err != nil && errHandler != nil && ctx.Err() == nil {
+					errHandler(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,84 @@
+package bybit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withServerTimeHandlerOption(skew time.Duration, calls *int32) func(*http.ServeMux) {
+	return func(mux *http.ServeMux) {
+		mux.HandleFunc("/v5/market/time", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(calls, 1)
+			now := time.Now().Add(skew)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"retCode":0,"retMsg":"OK","result":{"timeSecond":"` +
+				strconv.FormatInt(now.Unix(), 10) + `","timeNano":"` +
+				strconv.FormatInt(now.UnixNano(), 10) + `"}}`))
+		})
+	}
+}
+
+func TestClient_SyncServerTime(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withServerTimeHandlerOption(-3*time.Second, &calls),
+	)
+	defer teardown()
+
+	client := NewTestClient().
+		WithBaseURL(server.URL)
+
+	require.NoError(t, client.SyncServerTime())
+
+	status := client.ServerTimeStatus()
+	assert.InDelta(t, float64(3*time.Second), float64(status.Offset), float64(status.ErrorBound+50*time.Millisecond))
+	assert.False(t, status.SyncedAt.IsZero())
+	assert.InDelta(t, time.Now().Add(-3*time.Second).UnixMilli(), client.getTimestamp(), 100)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestClient_StartServerTimeSync(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withServerTimeHandlerOption(time.Second, &calls),
+		testhelper.WithHandlerOption("/v5/position/list", http.MethodGet, http.StatusOK, []byte(`{"retCode":0}`)),
+	)
+	defer teardown()
+
+	client := NewTestClient().
+		WithBaseURL(server.URL).
+		WithAuth("test", "test")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, client.StartServerTimeSync(ctx, 5*time.Millisecond, func(err error) {
+		t.Error(err)
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				_, err := client.V5().Position().GetPositionInfo(V5GetPositionInfoParam{Category: CategoryV5Linear})
+				assert.NoError(t, err)
+				time.Sleep(2 * time.Millisecond)
+			}
+		}()
+	}
+	wg.Wait()
+	cancel()
+
+	assert.Greater(t, atomic.LoadInt32(&calls), int32(1))
+	assert.InDelta(t, float64(-time.Second), float64(client.ServerTimeStatus().Offset), float64(100*time.Millisecond))
+}
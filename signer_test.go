@@ -0,0 +1,99 @@
+package bybit
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSigner struct {
+	payloads []string
+	err      error
+}
+
+func (s *fakeSigner) Sign(payload []byte) (string, SignType, error) {
+	s.payloads = append(s.payloads, string(payload))
+	return "fake-signature", SignTypeEd25519, s.err
+}
+
+func TestClient_WithSigner(t *testing.T) {
+	var captured *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"retCode":0}`))
+	}))
+	defer server.Close()
+
+	param := V5GetPositionInfoParam{Category: CategoryV5Linear}
+
+	t.Run("signs with the signer", func(t *testing.T) {
+		signer := &fakeSigner{}
+		client := NewClient().
+			WithBaseURL(server.URL).
+			WithSigner("test", signer)
+
+		_, err := client.V5().Position().GetPositionInfo(param)
+		require.NoError(t, err)
+
+		require.Len(t, signer.payloads, 1)
+		want := captured.Header.Get("X-BAPI-TIMESTAMP") + "test" + "5000" + captured.URL.Query().Encode()
+		assert.Equal(t, want, signer.payloads[0])
+		assert.Equal(t, "fake-signature", captured.Header.Get("X-BAPI-SIGN"))
+		assert.Equal(t, "2", captured.Header.Get("X-BAPI-SIGN-TYPE"))
+	})
+	t.Run("signer error", func(t *testing.T) {
+		captured = nil
+		wantErr := errors.New("agent unavailable")
+		client := NewClient().
+			WithBaseURL(server.URL).
+			WithSigner("test", &fakeSigner{err: wantErr})
+
+		_, err := client.V5().Position().GetPositionInfo(param)
+		assert.ErrorIs(t, err, wantErr)
+		assert.Nil(t, captured)
+	})
+	t.Run("websocket", func(t *testing.T) {
+		signer := &fakeSigner{}
+		wsClient := NewWebsocketClient().
+			WithSigner("test", signer)
+
+		_, err := wsClient.buildAuthParam()
+		require.NoError(t, err)
+		require.Len(t, signer.payloads, 1)
+		assert.Regexp(t, `^GET/realtime\d+$`, signer.payloads[0])
+	})
+}
+
+func TestHMACSigner(t *testing.T) {
+	signature, signType, err := NewHMACSigner("secret").Sign([]byte("1700000000000test5000category=linear"))
+	require.NoError(t, err)
+
+	assert.Equal(t, SignTypeHMAC, signType)
+	assert.Equal(t, "144696465e1d41a767ea7311785b226d7aa2b55744fbea6ddfca265793f62f60", signature)
+	assert.Empty(t, signType.header())
+}
+
+func TestRSASigner(t *testing.T) {
+	privateKey, err := LoadRSAPrivateKeyFromBytes([]byte(TestRSAPrivateKey))
+	require.NoError(t, err)
+
+	payload := []byte("1700000000000" + TestAPIKey + "5000" + "category=linear")
+	signature, signType, err := NewRSASigner(privateKey).Sign(payload)
+	require.NoError(t, err)
+	assert.Equal(t, SignTypeRSA, signType)
+	assert.Equal(t, "2", signType.header())
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	hashed := sha256.Sum256(payload)
+	assert.NoError(t, rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256, hashed[:], sig))
+}
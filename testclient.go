@@ -2,12 +2,6 @@ package bybit
 
 import (
 	"net/http"
-	"os"
-)
-
-const (
-	// TestNetBaseURL :
-	TestNetBaseURL = "https://api-testnet.bybit.com"
 )
 
 // TestClient :
@@ -22,22 +16,28 @@ func NewTestClient() *TestClient {
 			httpClient:        &http.Client{},
 			baseURL:           TestNetBaseURL,
 			checkResponseBody: checkResponseBody,
+			clock:             &serverClock{},
 		},
 	}
 }
 
-// WithAuthFromEnv :
+// WithAuthFromEnv : authenticates with the BYBIT_TEST_* credentials, see LoadConfigFromEnv
+// The base url is kept, WithEnvironmentFromEnv follows BYBIT_TEST_ENV.
 func (c *TestClient) WithAuthFromEnv() *TestClient {
-	key, ok := os.LookupEnv("BYBIT_TEST_KEY")
-	if !ok {
-		panic("need BYBIT_TEST_KEY as environment variable")
+	for _, opt := range testAuthOptionsFromEnv() {
+		if err := opt.client(c.Client); err != nil {
+			panic(err.Error())
+		}
 	}
-	secret, ok := os.LookupEnv("BYBIT_TEST_SECRET")
-	if !ok {
-		panic("need BYBIT_TEST_SECRET as environment variable")
+
+	return c
+}
+
+// WithEnvironmentFromEnv : switches to the environment named by BYBIT_TEST_ENV, e.g. demo, testnet when unset
+func (c *TestClient) WithEnvironmentFromEnv() *TestClient {
+	if err := testEnvironmentOptionFromEnv().client(c.Client); err != nil {
+		panic(err.Error())
 	}
-	c.key = key
-	c.secret = secret
 
 	return c
 }
@@ -1,12 +1,5 @@
 package bybit
 
-import "os"
-
-const (
-	// TestWebsocketBaseURL :
-	TestWebsocketBaseURL = "wss://stream-testnet.bybit.com"
-)
-
 // TestWebSocketClient :
 type TestWebSocketClient struct {
 	*WebSocketClient
@@ -21,18 +14,23 @@ func NewTestWebsocketClient() *TestWebSocketClient {
 	}
 }
 
-// WithAuthFromEnv :
+// WithAuthFromEnv : authenticates with the BYBIT_TEST_* credentials, see LoadConfigFromEnv
+// The base url is kept, WithEnvironmentFromEnv follows BYBIT_TEST_ENV.
 func (c *TestWebSocketClient) WithAuthFromEnv() *TestWebSocketClient {
-	key, ok := os.LookupEnv("BYBIT_TEST_KEY")
-	if !ok {
-		panic("need BYBIT_TEST_KEY as environment variable")
+	for _, opt := range testAuthOptionsFromEnv() {
+		if err := opt.websocket(c.WebSocketClient); err != nil {
+			panic(err.Error())
+		}
 	}
-	secret, ok := os.LookupEnv("BYBIT_TEST_SECRET")
-	if !ok {
-		panic("need BYBIT_TEST_SECRET as environment variable")
+
+	return c
+}
+
+// WithEnvironmentFromEnv : switches to the environment named by BYBIT_TEST_ENV, e.g. demo, testnet when unset
+func (c *TestWebSocketClient) WithEnvironmentFromEnv() *TestWebSocketClient {
+	if err := testEnvironmentOptionFromEnv().websocket(c.WebSocketClient); err != nil {
+		panic(err.Error())
 	}
-	c.key = key
-	c.secret = secret
 
 	return c
 }
@@ -0,0 +1,379 @@
+package testhelper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const scrubbedValue = "[REDACTED]"
+
+// scrubbedKeys are header names, query parameters and JSON fields which carry credentials
+var scrubbedKeys = map[string]bool{
+	"x-bapi-api-key": true,
+	"x-bapi-sign":    true,
+	"api_key":        true,
+	"apikey":         true,
+	"sign":           true,
+	"signature":      true,
+	"secret":         true,
+}
+
+// volatileKeys change on every request and are ignored when matching
+var volatileKeys = map[string]bool{
+	"timestamp":   true,
+	"recv_window": true,
+}
+
+// recordedHeaders are the response headers written to cassettes, the rest is noise
+var recordedHeaders = []string{
+	"Content-Type",
+	"Retry-After",
+	"X-Bapi-Limit",
+	"X-Bapi-Limit-Status",
+	"X-Bapi-Limit-Reset-Timestamp",
+	"Traceid",
+	"Timenow",
+}
+
+// ErrNoInteraction : the replayed cassette has no interaction matching the request
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+// Cassette : recorded request/response pairs
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction :
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest : a request with its credentials scrubbed
+type CassetteRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// CassetteResponse :
+type CassetteResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       string            `json:"body"`
+}
+
+// LoadCassette :
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("parse cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save : writes the cassette as indented JSON, creating the directory if needed
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Recorder : http.RoundTripper which passes requests on and records them with credentials scrubbed
+//
+//	recorder := testhelper.NewRecorder(nil)
+//	client := bybit.NewClient().WithHTTPClient(&http.Client{Transport: recorder})
+//	...
+//	err := recorder.Save("testdata/wallet_balance.json")
+type Recorder struct {
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder : records what transport returns, http.DefaultTransport when nil
+func NewRecorder(transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{transport: transport}
+}
+
+// RoundTrip :
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	header := map[string]string{}
+	for _, name := range recordedHeaders {
+		if v := resp.Header.Get(name); v != "" {
+			header[name] = v
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  scrubQuery(req.URL.RawQuery),
+			Body:   scrubBody(reqBody, req.Header.Get("Content-Type")),
+		},
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       scrubBody(respBody, resp.Header.Get("Content-Type")),
+		},
+	})
+	return resp, nil
+}
+
+// Cassette : a copy of what has been recorded so far
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &Cassette{Interactions: append([]Interaction{}, r.cassette.Interactions...)}
+}
+
+// Save : writes what has been recorded to path
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// Replayer : http.RoundTripper which serves a cassette without a network connection
+// Requests match on method, path and the query or body with credentials and timestamps ignored.
+// Identical requests are served in recorded order, the last match repeats once they are used up.
+type Replayer struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewReplayer :
+func NewReplayer(cassette *Cassette) *Replayer {
+	return &Replayer{
+		cassette: cassette,
+		used:     make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// LoadReplayer : NewReplayer with a cassette file
+func LoadReplayer(path string) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(cassette), nil
+}
+
+// RoundTrip :
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	want := CassetteRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+		Body:   string(reqBody),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !matchRequest(interaction.Request, want) {
+			continue
+		}
+		last = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if last < 0 {
+		return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+	}
+	r.used[last] = true
+
+	recorded := r.cassette.Interactions[last].Response
+	header := http.Header{}
+	for k, v := range recorded.Header {
+		header.Set(k, v)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Unused : the recorded requests which have not been replayed
+func (r *Replayer) Unused() []CassetteRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []CassetteRequest
+	for i, used := range r.used {
+		if !used {
+			result = append(result, r.cassette.Interactions[i].Request)
+		}
+	}
+	return result
+}
+
+func matchRequest(recorded CassetteRequest, req CassetteRequest) bool {
+	return recorded.Method == req.Method &&
+		recorded.Path == req.Path &&
+		normalizeQuery(recorded.Query) == normalizeQuery(req.Query) &&
+		normalizeBody(recorded.Body) == normalizeBody(req.Body)
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func isScrubbed(key string) bool {
+	return scrubbedKeys[strings.ToLower(key)]
+}
+
+func scrubQuery(rawQuery string) string {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	for key := range query {
+		if isScrubbed(key) {
+			query.Set(key, scrubbedValue)
+		}
+	}
+	return query.Encode()
+}
+
+// scrubBody scrubs JSON and form bodies, a body without credentials is kept byte for byte
+func scrubBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return scrubQuery(string(body))
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil || !scrubJSON(v) {
+		return string(body)
+	}
+	scrubbed, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+// scrubJSON replaces credentials in place, reporting whether it found any
+func scrubJSON(v interface{}) bool {
+	scrubbed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if s, ok := value.(string); ok && s != "" && isScrubbed(key) {
+				v[key] = scrubbedValue
+				scrubbed = true
+				continue
+			}
+			scrubbed = scrubJSON(value) || scrubbed
+		}
+	case []interface{}:
+		for _, value := range v {
+			scrubbed = scrubJSON(value) || scrubbed
+		}
+	}
+	return scrubbed
+}
+
+// normalizeQuery sorts the parameters and drops credentials and timestamps
+func normalizeQuery(rawQuery string) string {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	for key := range query {
+		if isScrubbed(key) || volatileKeys[strings.ToLower(key)] {
+			query.Del(key)
+		}
+	}
+	return query.Encode()
+}
+
+// normalizeBody sorts JSON fields and drops credentials and timestamps, form bodies are normalized like queries
+func normalizeBody(body string) string {
+	if body == "" {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return normalizeQuery(body)
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		for key := range m {
+			if isScrubbed(key) || volatileKeys[strings.ToLower(key)] {
+				delete(m, key)
+			}
+		}
+	}
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return string(normalized)
+}
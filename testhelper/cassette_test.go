@@ -0,0 +1,100 @@
+package testhelper_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassette(t *testing.T) {
+	var calls int
+	server, teardown := testhelper.NewServer(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v5/user/create-sub-api", func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Bapi-Limit-Status", "9")
+			w.Header().Set("Set-Cookie", "session=1")
+			_, _ = w.Write([]byte(`{"retCode":0,"result":{"apiKey":"sub-key","secret":"sub-secret","note":"bot"}}`))
+		})
+		mux.HandleFunc("/v5/market/time", func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"retCode":0,"time":` + r.URL.Query().Get("n") + `}`))
+		})
+	})
+	defer teardown()
+
+	recorder := testhelper.NewRecorder(nil)
+	httpClient := &http.Client{Transport: recorder}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v5/user/create-sub-api", strings.NewReader(`{"subuid":1,"note":"bot"}`))
+	require.NoError(t, err)
+	req.Header.Set("X-BAPI-API-KEY", "master-key")
+	req.Header.Set("X-BAPI-SIGN", "signature")
+	req.Header.Set("X-BAPI-TIMESTAMP", "1")
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "sub-secret", "the caller gets the real response")
+
+	for _, n := range []string{"1", "2"} {
+		resp, err := httpClient.Get(server.URL + "/v5/market/time?n=" + n + "&api_key=key&timestamp=" + n + "&sign=x")
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	path := filepath.Join(t.TempDir(), "cassettes", "user.json")
+	require.NoError(t, recorder.Save(path))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"master-key", "signature", "sub-key", "sub-secret", "session", "sign=x"} {
+		assert.NotContains(t, string(raw), secret)
+	}
+	assert.Contains(t, string(raw), "X-Bapi-Limit-Status")
+
+	teardown()
+	replayer, err := testhelper.LoadReplayer(path)
+	require.NoError(t, err)
+	httpClient = &http.Client{Transport: replayer}
+
+	t.Run("body with reordered fields", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "https://api.bybit.com/v5/user/create-sub-api", strings.NewReader(`{"note":"bot","subuid":1}`))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"retCode":0,"result":{"apiKey":"[REDACTED]","secret":"[REDACTED]","note":"bot"}}`, string(body))
+		assert.Equal(t, "9", resp.Header.Get("X-Bapi-Limit-Status"))
+	})
+	t.Run("query ignoring credentials and timestamp", func(t *testing.T) {
+		assert.Len(t, replayer.Unused(), 2)
+		for _, n := range []string{"2", "1", "1"} {
+			resp, err := httpClient.Get("https://api.bybit.com/v5/market/time?timestamp=99&n=" + n)
+			require.NoError(t, err)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, `{"retCode":0,"time":`+n+`}`, string(body))
+		}
+		assert.Empty(t, replayer.Unused())
+	})
+	t.Run("no match", func(t *testing.T) {
+		_, err := httpClient.Get("https://api.bybit.com/v5/market/time?n=3")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, testhelper.ErrNoInteraction))
+	})
+	assert.Equal(t, 3, calls)
+}
@@ -0,0 +1,925 @@
+package testhelper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultExchangeMakerFeeRate :
+	DefaultExchangeMakerFeeRate = 0.0002
+	// DefaultExchangeTakerFeeRate :
+	DefaultExchangeTakerFeeRate = 0.00055
+	// DefaultExchangeLeverage : the leverage of every derivatives position of the fake
+	DefaultExchangeLeverage = 10
+)
+
+const (
+	exchangeStatusNew       = "New"
+	exchangeStatusFilled    = "Filled"
+	exchangeStatusCancelled = "Cancelled"
+)
+
+// Exchange : an in-process fake of the V5 order, position, execution and wallet endpoints
+// Orders fill completely or not at all: market orders and marketable limit orders fill at the
+// last price set with SetPrice, other limit orders rest until SetPrice crosses them and then fill
+// at their own price as maker. Linear fills update one-way positions settled in the quote coin,
+// inverse ones are modelled the same way settled in the base coin, spot fills move the base and
+// quote balances. Every position uses DefaultExchangeLeverage, there is no liquidation.
+//
+//	exchange := testhelper.NewExchange().SetBalance("USDT", 10000).SetPrice("BTCUSDT", 30000)
+//	server, teardown := testhelper.NewServer(testhelper.WithExchangeOption(exchange))
+//	client := bybit.NewClient().WithBaseURL(server.URL).WithAuth("key", "secret")
+type Exchange struct {
+	mu sync.Mutex
+
+	makerFeeRate float64
+	takerFeeRate float64
+
+	seq        int
+	prices     map[string]float64
+	balances   map[string]float64
+	orders     []*exchangeOrder
+	positions  map[string]*exchangePosition
+	executions []*exchangeExecution
+}
+
+type exchangeOrder struct {
+	OrderID      string  `json:"orderId"`
+	OrderLinkID  string  `json:"orderLinkId"`
+	Symbol       string  `json:"symbol"`
+	Side         string  `json:"side"`
+	OrderType    string  `json:"orderType"`
+	TimeInForce  string  `json:"timeInForce"`
+	Price        decimal `json:"price"`
+	Qty          decimal `json:"qty"`
+	CumExecQty   decimal `json:"cumExecQty"`
+	CumExecValue decimal `json:"cumExecValue"`
+	CumExecFee   decimal `json:"cumExecFee"`
+	AvgPrice     decimal `json:"avgPrice"`
+	LeavesQty    decimal `json:"leavesQty"`
+	LeavesValue  decimal `json:"leavesValue"`
+	OrderStatus  string  `json:"orderStatus"`
+	RejectReason string  `json:"rejectReason"`
+	CancelType   string  `json:"cancelType"`
+	ReduceOnly   bool    `json:"reduceOnly"`
+	PositionIdx  int     `json:"positionIdx"`
+	CreatedTime  string  `json:"createdTime"`
+	UpdatedTime  string  `json:"updatedTime"`
+
+	category string
+}
+
+type exchangePosition struct {
+	Symbol         string  `json:"symbol"`
+	Side           string  `json:"side"`
+	Size           decimal `json:"size"`
+	AvgPrice       decimal `json:"avgPrice"`
+	PositionValue  decimal `json:"positionValue"`
+	Leverage       decimal `json:"leverage"`
+	MarkPrice      decimal `json:"markPrice"`
+	PositionIM     decimal `json:"positionIM"`
+	UnrealisedPnl  decimal `json:"unrealisedPnl"`
+	CurRealisedPnl decimal `json:"curRealisedPnl"`
+	CumRealisedPnl decimal `json:"cumRealisedPnl"`
+	PositionIdx    int     `json:"positionIdx"`
+	PositionStatus string  `json:"positionStatus"`
+	CreatedTime    string  `json:"createdTime"`
+	UpdatedTime    string  `json:"updatedTime"`
+
+	category string
+	// signedSize is negative for shorts
+	signedSize float64
+}
+
+type exchangeExecution struct {
+	Symbol      string  `json:"symbol"`
+	OrderID     string  `json:"orderId"`
+	OrderLinkID string  `json:"orderLinkId"`
+	Side        string  `json:"side"`
+	OrderPrice  decimal `json:"orderPrice"`
+	OrderQty    decimal `json:"orderQty"`
+	LeavesQty   decimal `json:"leavesQty"`
+	OrderType   string  `json:"orderType"`
+	ExecID      string  `json:"execId"`
+	ExecPrice   decimal `json:"execPrice"`
+	ExecQty     decimal `json:"execQty"`
+	ExecValue   decimal `json:"execValue"`
+	ExecFee     decimal `json:"execFee"`
+	ExecType    string  `json:"execType"`
+	ExecTime    string  `json:"execTime"`
+	FeeRate     decimal `json:"feeRate"`
+	FeeCurrency string  `json:"feeCurrency"`
+	IsMaker     bool    `json:"isMaker"`
+	MarkPrice   decimal `json:"markPrice"`
+	ClosedSize  decimal `json:"closedSize"`
+
+	category string
+}
+
+// decimal renders floats the way Bybit does, as strings without exponent or float noise
+type decimal float64
+
+// MarshalJSON :
+func (d decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(formatDecimal(float64(d)))
+}
+
+func formatDecimal(v float64) string {
+	s := strconv.FormatFloat(math.Round(v*1e8)/1e8, 'f', 8, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// exchangeError is answered with HTTP 200 and its retCode, like Bybit does
+type exchangeError struct {
+	retCode int
+	retMsg  string
+}
+
+func newExchangeError(retCode int, format string, args ...interface{}) *exchangeError {
+	return &exchangeError{retCode: retCode, retMsg: fmt.Sprintf(format, args...)}
+}
+
+// NewExchange : an empty exchange, fund it with SetBalance and quote symbols with SetPrice
+func NewExchange() *Exchange {
+	return &Exchange{
+		makerFeeRate: DefaultExchangeMakerFeeRate,
+		takerFeeRate: DefaultExchangeTakerFeeRate,
+		prices:       map[string]float64{},
+		balances:     map[string]float64{},
+		positions:    map[string]*exchangePosition{},
+	}
+}
+
+// WithFeeRates :
+func (e *Exchange) WithFeeRates(maker, taker float64) *Exchange {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.makerFeeRate = maker
+	e.takerFeeRate = taker
+
+	return e
+}
+
+// SetBalance : sets the wallet balance of a coin
+func (e *Exchange) SetBalance(coin string, amount float64) *Exchange {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.balances[coin] = amount
+
+	return e
+}
+
+// Balance : the wallet balance of a coin, realised PnL and fees included
+func (e *Exchange) Balance(coin string) float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.balances[coin]
+}
+
+// SetPrice : moves the last price of a symbol, filling the resting limit orders it crosses
+func (e *Exchange) SetPrice(symbol string, price float64) *Exchange {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.prices[symbol] = price
+	for _, order := range e.orders {
+		if order.Symbol != symbol || !order.isOpen() {
+			continue
+		}
+		if order.crosses(price) {
+			e.fill(order, float64(order.Price), true)
+		}
+	}
+
+	return e
+}
+
+// WithExchangeOption : serves the exchange on the V5 paths of a NewServer mux
+func WithExchangeOption(e *Exchange) func(*http.ServeMux) {
+	return func(mux *http.ServeMux) {
+		routes := map[string]func(url.Values, map[string]interface{}) (interface{}, *exchangeError){
+			"/v5/order/create":           e.createOrder,
+			"/v5/order/amend":            e.amendOrder,
+			"/v5/order/cancel":           e.cancelOrder,
+			"/v5/order/cancel-all":       e.cancelAllOrders,
+			"/v5/order/realtime":         e.openOrders,
+			"/v5/order/history":          e.historyOrders,
+			"/v5/position/list":          e.positionList,
+			"/v5/execution/list":         e.executionList,
+			"/v5/account/wallet-balance": e.walletBalance,
+		}
+		for path, route := range routes {
+			mux.HandleFunc(path, e.handler(route))
+		}
+		mux.HandleFunc("/v5/market/time", func(w http.ResponseWriter, r *http.Request) {
+			now := time.Now()
+			writeExchangeResponse(w, map[string]string{
+				"timeSecond": strconv.FormatInt(now.Unix(), 10),
+				"timeNano":   strconv.FormatInt(now.UnixNano(), 10),
+			}, nil)
+		})
+	}
+}
+
+func (e *Exchange) handler(route func(url.Values, map[string]interface{}) (interface{}, *exchangeError)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-BAPI-API-KEY") == "" {
+			writeExchangeResponse(w, nil, newExchangeError(10003, "API key is invalid."))
+			return
+		}
+		body := map[string]interface{}{}
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writeExchangeResponse(w, nil, newExchangeError(10001, "invalid request body: %v", err))
+				return
+			}
+		}
+
+		e.mu.Lock()
+		result, err := route(r.URL.Query(), body)
+		e.mu.Unlock()
+
+		writeExchangeResponse(w, result, err)
+	}
+}
+
+func writeExchangeResponse(w http.ResponseWriter, result interface{}, err *exchangeError) {
+	resp := map[string]interface{}{
+		"retCode":    0,
+		"retMsg":     "OK",
+		"result":     result,
+		"retExtInfo": map[string]interface{}{},
+		"time":       time.Now().UnixMilli(),
+	}
+	if err != nil {
+		resp["retCode"] = err.retCode
+		resp["retMsg"] = err.retMsg
+		resp["result"] = map[string]interface{}{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (e *Exchange) nextID(prefix string) string {
+	e.seq++
+	return fmt.Sprintf("%s-%08d", prefix, e.seq)
+}
+
+func exchangeNow() string {
+	return strconv.FormatInt(time.Now().UnixMilli(), 10)
+}
+
+func stringField(body map[string]interface{}, key string) string {
+	s, _ := body[key].(string)
+	return s
+}
+
+func boolField(body map[string]interface{}, keys ...string) bool {
+	for _, key := range keys {
+		if b, ok := body[key].(bool); ok && b {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePositive(name, value string) (float64, *exchangeError) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil || v <= 0 {
+		return 0, newExchangeError(10001, "%s invalid: %q", name, value)
+	}
+	return v, nil
+}
+
+func validCategory(category string) *exchangeError {
+	switch category {
+	case "linear", "inverse", "spot":
+		return nil
+	default:
+		return newExchangeError(10001, "category invalid: %q", category)
+	}
+}
+
+// settleCoin is the quote coin for linear and spot, the base coin for inverse
+func settleCoin(category, symbol string) string {
+	for _, quote := range []string{"USDT", "USDC", "PERP"} {
+		if strings.HasSuffix(symbol, quote) {
+			if category == "inverse" {
+				return strings.TrimSuffix(symbol, quote)
+			}
+			if quote == "PERP" {
+				return "USDC"
+			}
+			return quote
+		}
+	}
+	return strings.TrimSuffix(symbol, "USD")
+}
+
+func baseCoin(symbol string) string {
+	for _, quote := range []string{"USDT", "USDC", "PERP", "USD"} {
+		if strings.HasSuffix(symbol, quote) {
+			return strings.TrimSuffix(symbol, quote)
+		}
+	}
+	return symbol
+}
+
+func (o *exchangeOrder) isOpen() bool {
+	return o.OrderStatus == exchangeStatusNew
+}
+
+func (o *exchangeOrder) crosses(price float64) bool {
+	if o.OrderType == "Market" {
+		return true
+	}
+	if o.Side == "Buy" {
+		return float64(o.Price) >= price
+	}
+	return float64(o.Price) <= price
+}
+
+func (o *exchangeOrder) sign() float64 {
+	if o.Side == "Buy" {
+		return 1
+	}
+	return -1
+}
+
+func (e *Exchange) findOrder(body map[string]interface{}) *exchangeOrder {
+	orderID, orderLinkID := stringField(body, "orderId"), stringField(body, "orderLinkId")
+	for _, order := range e.orders {
+		if order.Symbol != stringField(body, "symbol") {
+			continue
+		}
+		if (orderID != "" && order.OrderID == orderID) || (orderID == "" && orderLinkID != "" && order.OrderLinkID == orderLinkID) {
+			return order
+		}
+	}
+	return nil
+}
+
+func (e *Exchange) createOrder(_ url.Values, body map[string]interface{}) (interface{}, *exchangeError) {
+	order := &exchangeOrder{
+		OrderLinkID: stringField(body, "orderLinkId"),
+		Symbol:      stringField(body, "symbol"),
+		Side:        stringField(body, "side"),
+		OrderType:   stringField(body, "orderType"),
+		TimeInForce: stringField(body, "timeInForce"),
+		ReduceOnly:  boolField(body, "reduceOnly", "reduce_only"),
+		category:    stringField(body, "category"),
+	}
+	if err := validCategory(order.category); err != nil {
+		return nil, err
+	}
+	if order.Symbol == "" {
+		return nil, newExchangeError(10001, "symbol invalid")
+	}
+	if order.Side != "Buy" && order.Side != "Sell" {
+		return nil, newExchangeError(10001, "side invalid: %q", order.Side)
+	}
+	qty, err := parsePositive("qty", stringField(body, "qty"))
+	if err != nil {
+		return nil, err
+	}
+	order.Qty = decimal(qty)
+
+	lastPrice, quoted := e.prices[order.Symbol]
+	switch order.OrderType {
+	case "Market":
+		if !quoted {
+			return nil, newExchangeError(10001, "no price for symbol %s", order.Symbol)
+		}
+		order.TimeInForce = "IOC"
+	case "Limit":
+		price, err := parsePositive("price", stringField(body, "price"))
+		if err != nil {
+			return nil, err
+		}
+		order.Price = decimal(price)
+		if order.TimeInForce == "" {
+			order.TimeInForce = "GTC"
+		}
+	default:
+		return nil, newExchangeError(10001, "orderType invalid: %q", order.OrderType)
+	}
+	if order.OrderLinkID != "" {
+		for _, o := range e.orders {
+			if o.OrderLinkID == order.OrderLinkID {
+				return nil, newExchangeError(110072, "OrderLinkedID is duplicate")
+			}
+		}
+	}
+	if err := e.checkOrder(order, lastPrice); err != nil {
+		return nil, err
+	}
+
+	order.OrderID = e.nextID("order")
+	order.OrderStatus = exchangeStatusNew
+	order.LeavesQty = order.Qty
+	order.LeavesValue = decimal(qty * float64(order.Price))
+	order.CreatedTime = exchangeNow()
+	order.UpdatedTime = order.CreatedTime
+	e.orders = append(e.orders, order)
+
+	e.match(order, lastPrice, quoted)
+
+	return map[string]string{"orderId": order.OrderID, "orderLinkId": order.OrderLinkID}, nil
+}
+
+// match fills or cancels a new or amended order against the last price
+func (e *Exchange) match(order *exchangeOrder, lastPrice float64, quoted bool) {
+	marketable := quoted && order.crosses(lastPrice)
+	switch {
+	case marketable && order.TimeInForce == "PostOnly":
+		order.OrderStatus = exchangeStatusCancelled
+		order.RejectReason = "EC_PostOnlyWillTakeLiquidity"
+		order.CancelType = "CancelByUser"
+	case marketable:
+		e.fill(order, lastPrice, false)
+	case order.TimeInForce == "IOC" || order.TimeInForce == "FOK":
+		order.OrderStatus = exchangeStatusCancelled
+		order.RejectReason = "EC_NoImmediateQtyToFill"
+		order.CancelType = "CancelByUser"
+	}
+	order.UpdatedTime = exchangeNow()
+}
+
+// checkOrder rejects reduce-only orders which would not reduce and orders the wallet cannot pay for
+func (e *Exchange) checkOrder(order *exchangeOrder, lastPrice float64) *exchangeError {
+	qty := float64(order.Qty)
+	price := float64(order.Price)
+	if order.OrderType == "Market" {
+		price = lastPrice
+	}
+
+	if order.category == "spot" {
+		if order.Side == "Buy" {
+			if e.balances[settleCoin("spot", order.Symbol)] < qty*price*(1+e.takerFeeRate) {
+				return newExchangeError(170131, "Insufficient balance.")
+			}
+		} else if e.balances[baseCoin(order.Symbol)] < qty {
+			return newExchangeError(170131, "Insufficient balance.")
+		}
+		return nil
+	}
+
+	position := e.positions[order.category+order.Symbol]
+	if order.ReduceOnly {
+		if position == nil || position.signedSize*order.sign() >= 0 || qty > math.Abs(position.signedSize)+1e-12 {
+			return newExchangeError(110017, "current position is zero, cannot fix reduce-only order qty")
+		}
+		return nil
+	}
+	if e.availableBalance(settleCoin(order.category, order.Symbol)) < qty*price/DefaultExchangeLeverage {
+		return newExchangeError(110007, "ab not enough for new order")
+	}
+	return nil
+}
+
+// availableBalance is the wallet balance less the margin of positions and open orders
+func (e *Exchange) availableBalance(coin string) float64 {
+	available := e.balances[coin] + e.unrealisedPnl(coin)
+	for _, position := range e.positions {
+		if settleCoin(position.category, position.Symbol) == coin {
+			available -= math.Abs(position.signedSize) * float64(position.AvgPrice) / DefaultExchangeLeverage
+		}
+	}
+	for _, order := range e.orders {
+		if order.isOpen() && order.category != "spot" && !order.ReduceOnly && settleCoin(order.category, order.Symbol) == coin {
+			available -= float64(order.LeavesQty) * float64(order.Price) / DefaultExchangeLeverage
+		}
+	}
+	return available
+}
+
+func (e *Exchange) unrealisedPnl(coin string) float64 {
+	var pnl float64
+	for _, position := range e.positions {
+		if settleCoin(position.category, position.Symbol) != coin {
+			continue
+		}
+		if price, ok := e.prices[position.Symbol]; ok {
+			pnl += position.signedSize * (price - float64(position.AvgPrice))
+		}
+	}
+	return pnl
+}
+
+func (e *Exchange) fill(order *exchangeOrder, price float64, maker bool) {
+	qty := float64(order.LeavesQty)
+	value := qty * price
+	feeRate := e.takerFeeRate
+	if maker {
+		feeRate = e.makerFeeRate
+	}
+	fee := value * feeRate
+	now := exchangeNow()
+
+	execution := &exchangeExecution{
+		Symbol:      order.Symbol,
+		OrderID:     order.OrderID,
+		OrderLinkID: order.OrderLinkID,
+		Side:        order.Side,
+		OrderPrice:  order.Price,
+		OrderQty:    order.Qty,
+		OrderType:   order.OrderType,
+		ExecID:      e.nextID("exec"),
+		ExecPrice:   decimal(price),
+		ExecQty:     decimal(qty),
+		ExecValue:   decimal(value),
+		ExecFee:     decimal(fee),
+		ExecType:    "Trade",
+		ExecTime:    now,
+		FeeRate:     decimal(feeRate),
+		FeeCurrency: settleCoin(order.category, order.Symbol),
+		IsMaker:     maker,
+		MarkPrice:   decimal(price),
+		category:    order.category,
+	}
+
+	if order.category == "spot" {
+		base, quote := baseCoin(order.Symbol), settleCoin("spot", order.Symbol)
+		e.balances[base] += order.sign() * qty
+		e.balances[quote] -= order.sign()*value + fee
+	} else {
+		execution.ClosedSize = decimal(e.updatePosition(order, qty, price, fee))
+	}
+	e.executions = append(e.executions, execution)
+
+	order.CumExecQty += decimal(qty)
+	order.CumExecValue += decimal(value)
+	order.CumExecFee += decimal(fee)
+	order.AvgPrice = order.CumExecValue / order.CumExecQty
+	order.LeavesQty = 0
+	order.LeavesValue = 0
+	order.OrderStatus = exchangeStatusFilled
+	order.UpdatedTime = now
+}
+
+// updatePosition applies a fill to the one-way position and returns the closed size
+func (e *Exchange) updatePosition(order *exchangeOrder, qty, price, fee float64) float64 {
+	key := order.category + order.Symbol
+	position, ok := e.positions[key]
+	if !ok {
+		position = &exchangePosition{
+			Symbol:      order.Symbol,
+			Leverage:    DefaultExchangeLeverage,
+			CreatedTime: exchangeNow(),
+			category:    order.category,
+		}
+		e.positions[key] = position
+	}
+	coin := settleCoin(order.category, order.Symbol)
+
+	trade := order.sign() * qty
+	closed := 0.0
+	if position.signedSize*trade < 0 {
+		closed = math.Min(math.Abs(position.signedSize), qty)
+		realised := closed * (price - float64(position.AvgPrice))
+		if position.signedSize < 0 {
+			realised = -realised
+		}
+		e.balances[coin] += realised
+		position.CurRealisedPnl += decimal(realised)
+		position.CumRealisedPnl += decimal(realised)
+		position.signedSize += math.Copysign(closed, trade)
+		trade -= math.Copysign(closed, trade)
+	}
+	if trade != 0 {
+		size := math.Abs(position.signedSize)
+		position.AvgPrice = decimal((size*float64(position.AvgPrice) + math.Abs(trade)*price) / (size + math.Abs(trade)))
+		position.signedSize += trade
+	}
+	if math.Abs(position.signedSize) < 1e-12 {
+		position.signedSize = 0
+		position.AvgPrice = 0
+	}
+
+	e.balances[coin] -= fee
+	position.CurRealisedPnl -= decimal(fee)
+	position.CumRealisedPnl -= decimal(fee)
+	position.UpdatedTime = exchangeNow()
+	return closed
+}
+
+func (e *Exchange) amendOrder(_ url.Values, body map[string]interface{}) (interface{}, *exchangeError) {
+	if err := validCategory(stringField(body, "category")); err != nil {
+		return nil, err
+	}
+	order := e.findOrder(body)
+	if order == nil || !order.isOpen() {
+		return nil, newExchangeError(110001, "order not exists or too late to replace")
+	}
+	if qty := stringField(body, "qty"); qty != "" {
+		v, err := parsePositive("qty", qty)
+		if err != nil {
+			return nil, err
+		}
+		order.Qty = decimal(v)
+		order.LeavesQty = decimal(v)
+	}
+	if price := stringField(body, "price"); price != "" {
+		if order.OrderType != "Limit" {
+			return nil, newExchangeError(10001, "price can only be amended on limit orders")
+		}
+		v, err := parsePositive("price", price)
+		if err != nil {
+			return nil, err
+		}
+		order.Price = decimal(v)
+	}
+	order.LeavesValue = order.LeavesQty * order.Price
+
+	lastPrice, quoted := e.prices[order.Symbol]
+	e.match(order, lastPrice, quoted)
+
+	return map[string]string{"orderId": order.OrderID, "orderLinkId": order.OrderLinkID}, nil
+}
+
+func (e *Exchange) cancel(order *exchangeOrder) {
+	order.OrderStatus = exchangeStatusCancelled
+	order.CancelType = "CancelByUser"
+	order.LeavesValue = 0
+	order.UpdatedTime = exchangeNow()
+}
+
+func (e *Exchange) cancelOrder(_ url.Values, body map[string]interface{}) (interface{}, *exchangeError) {
+	if err := validCategory(stringField(body, "category")); err != nil {
+		return nil, err
+	}
+	order := e.findOrder(body)
+	if order == nil || !order.isOpen() {
+		return nil, newExchangeError(110001, "order not exists or too late to cancel")
+	}
+	e.cancel(order)
+
+	return map[string]string{"orderId": order.OrderID, "orderLinkId": order.OrderLinkID}, nil
+}
+
+func (e *Exchange) cancelAllOrders(_ url.Values, body map[string]interface{}) (interface{}, *exchangeError) {
+	category := stringField(body, "category")
+	if err := validCategory(category); err != nil {
+		return nil, err
+	}
+	symbol, base, settle := stringField(body, "symbol"), stringField(body, "baseCoin"), stringField(body, "settleCoin")
+	if category != "spot" && symbol == "" && base == "" && settle == "" {
+		return nil, newExchangeError(10001, "symbol or settleCoin is required")
+	}
+
+	list := []map[string]string{}
+	for _, order := range e.orders {
+		switch {
+		case !order.isOpen() || order.category != category:
+		case symbol != "" && order.Symbol != symbol:
+		case base != "" && baseCoin(order.Symbol) != base:
+		case settle != "" && settleCoin(order.category, order.Symbol) != settle:
+		default:
+			e.cancel(order)
+			list = append(list, map[string]string{"orderId": order.OrderID, "orderLinkId": order.OrderLinkID})
+		}
+	}
+	return map[string]interface{}{"list": list, "success": "1"}, nil
+}
+
+// page applies limit and cursor, the cursor being the offset of the next page
+func page[T any](items []T, query url.Values, defaultLimit int) ([]T, string, *exchangeError) {
+	limit := defaultLimit
+	if v := query.Get("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l < 1 {
+			return nil, "", newExchangeError(10001, "limit invalid: %q", v)
+		}
+		limit = l
+	}
+	offset := 0
+	if v := query.Get("cursor"); v != "" {
+		o, err := strconv.Atoi(v)
+		if err != nil || o < 0 {
+			return nil, "", newExchangeError(10001, "cursor invalid: %q", v)
+		}
+		offset = o
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + limit
+	if end >= len(items) {
+		return items[offset:], "", nil
+	}
+	return items[offset:end], strconv.Itoa(end), nil
+}
+
+func (e *Exchange) queryOrders(query url.Values, open bool) (interface{}, *exchangeError) {
+	category := query.Get("category")
+	if err := validCategory(category); err != nil {
+		return nil, err
+	}
+	orderID, orderLinkID := query.Get("orderId"), query.Get("orderLinkId")
+	byID := orderID != "" || orderLinkID != ""
+
+	var list []*exchangeOrder
+	for i := len(e.orders) - 1; i >= 0; i-- {
+		order := e.orders[i]
+		switch {
+		case order.category != category:
+		case query.Get("symbol") != "" && order.Symbol != query.Get("symbol"):
+		case orderID != "" && order.OrderID != orderID:
+		case orderLinkID != "" && order.OrderLinkID != orderLinkID:
+		case query.Get("orderStatus") != "" && order.OrderStatus != query.Get("orderStatus"):
+		// realtime also finds closed orders by id, like Bybit does for recent ones
+		case open && !byID && !order.isOpen():
+		case !open && order.isOpen():
+		default:
+			list = append(list, order)
+		}
+	}
+	list, cursor, err := page(list, query, 20)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		list = []*exchangeOrder{}
+	}
+	return map[string]interface{}{"category": category, "nextPageCursor": cursor, "list": list}, nil
+}
+
+func (e *Exchange) openOrders(query url.Values, _ map[string]interface{}) (interface{}, *exchangeError) {
+	return e.queryOrders(query, true)
+}
+
+func (e *Exchange) historyOrders(query url.Values, _ map[string]interface{}) (interface{}, *exchangeError) {
+	return e.queryOrders(query, false)
+}
+
+func (e *Exchange) positionList(query url.Values, _ map[string]interface{}) (interface{}, *exchangeError) {
+	category := query.Get("category")
+	if category != "linear" && category != "inverse" {
+		return nil, newExchangeError(10001, "category invalid: %q", category)
+	}
+	symbol, settle := query.Get("symbol"), query.Get("settleCoin")
+	if symbol == "" && settle == "" {
+		return nil, newExchangeError(10001, "symbol or settleCoin is required")
+	}
+
+	var list []*exchangePosition
+	for _, position := range e.positions {
+		switch {
+		case position.category != category:
+		case symbol != "" && position.Symbol != symbol:
+		case symbol == "" && settleCoin(category, position.Symbol) != settle:
+		case symbol == "" && position.signedSize == 0:
+		default:
+			list = append(list, e.renderPosition(position))
+		}
+	}
+	if symbol != "" && len(list) == 0 {
+		list = append(list, e.renderPosition(&exchangePosition{Symbol: symbol, Leverage: DefaultExchangeLeverage, category: category}))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Symbol < list[j].Symbol
+	})
+	list, cursor, err := page(list, query, 20)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"category": category, "nextPageCursor": cursor, "list": list}, nil
+}
+
+func (e *Exchange) renderPosition(position *exchangePosition) *exchangePosition {
+	p := *position
+	size := math.Abs(p.signedSize)
+	p.Size = decimal(size)
+	p.PositionStatus = "Normal"
+	switch {
+	case p.signedSize > 0:
+		p.Side = "Buy"
+	case p.signedSize < 0:
+		p.Side = "Sell"
+	default:
+		p.Side = ""
+	}
+	p.PositionValue = decimal(size * float64(p.AvgPrice))
+	p.PositionIM = p.PositionValue / DefaultExchangeLeverage
+	if price, ok := e.prices[p.Symbol]; ok {
+		p.MarkPrice = decimal(price)
+		p.UnrealisedPnl = decimal(p.signedSize * (price - float64(p.AvgPrice)))
+	}
+	return &p
+}
+
+func (e *Exchange) executionList(query url.Values, _ map[string]interface{}) (interface{}, *exchangeError) {
+	category := query.Get("category")
+	if err := validCategory(category); err != nil {
+		return nil, err
+	}
+
+	var list []*exchangeExecution
+	for i := len(e.executions) - 1; i >= 0; i-- {
+		execution := e.executions[i]
+		switch {
+		case execution.category != category:
+		case query.Get("symbol") != "" && execution.Symbol != query.Get("symbol"):
+		case query.Get("orderId") != "" && execution.OrderID != query.Get("orderId"):
+		case query.Get("orderLinkId") != "" && execution.OrderLinkID != query.Get("orderLinkId"):
+		default:
+			list = append(list, execution)
+		}
+	}
+	list, cursor, err := page(list, query, 50)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		list = []*exchangeExecution{}
+	}
+	return map[string]interface{}{"category": category, "nextPageCursor": cursor, "list": list}, nil
+}
+
+// usdPrice values stablecoins at one and other coins at their last USDT price
+func (e *Exchange) usdPrice(coin string) float64 {
+	switch coin {
+	case "USDT", "USDC", "USD":
+		return 1
+	default:
+		return e.prices[coin+"USDT"]
+	}
+}
+
+func (e *Exchange) walletBalance(query url.Values, _ map[string]interface{}) (interface{}, *exchangeError) {
+	accountType := query.Get("accountType")
+	switch accountType {
+	case "UNIFIED", "CONTRACT", "SPOT":
+	default:
+		return nil, newExchangeError(10001, "accountType invalid: %q", accountType)
+	}
+	var coins []string
+	if v := query.Get("coin"); v != "" {
+		coins = strings.Split(v, ",")
+	} else {
+		for coin, balance := range e.balances {
+			if balance != 0 {
+				coins = append(coins, coin)
+			}
+		}
+		sort.Strings(coins)
+	}
+
+	var totalEquity, totalWallet, totalAvailable, totalUPL, totalIM float64
+	list := []map[string]interface{}{}
+	for _, coin := range coins {
+		wallet, upl := e.balances[coin], e.unrealisedPnl(coin)
+		available := e.availableBalance(coin)
+		usd := e.usdPrice(coin)
+		im := wallet + upl - available
+		totalEquity += (wallet + upl) * usd
+		totalWallet += wallet * usd
+		totalAvailable += available * usd
+		totalUPL += upl * usd
+		totalIM += im * usd
+
+		list = append(list, map[string]interface{}{
+			"coin":                coin,
+			"equity":              decimal(wallet + upl),
+			"usdValue":            decimal((wallet + upl) * usd),
+			"walletBalance":       decimal(wallet),
+			"free":                decimal(available),
+			"locked":              "0",
+			"availableToWithdraw": decimal(available),
+			"totalPositionIM":     decimal(im),
+			"unrealisedPnl":       decimal(upl),
+			"cumRealisedPnl":      "0",
+			"marginCollateral":    true,
+			"collateralSwitch":    true,
+		})
+	}
+
+	return map[string]interface{}{
+		"list": []map[string]interface{}{{
+			"accountType":           accountType,
+			"totalEquity":           decimal(totalEquity),
+			"totalWalletBalance":    decimal(totalWallet),
+			"totalMarginBalance":    decimal(totalEquity),
+			"totalAvailableBalance": decimal(totalAvailable),
+			"totalPerpUPL":          decimal(totalUPL),
+			"totalInitialMargin":    decimal(totalIM),
+			"coin":                  list,
+		}},
+	}, nil
+}
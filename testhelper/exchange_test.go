@@ -0,0 +1,157 @@
+package testhelper_test
+
+import (
+	"testing"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchange(t *testing.T) {
+	exchange := testhelper.NewExchange().
+		WithFeeRates(0, 0.001).
+		SetBalance("USDT", 10000).
+		SetPrice("BTCUSDT", 30000)
+	server, teardown := testhelper.NewServer(testhelper.WithExchangeOption(exchange))
+	defer teardown()
+
+	client := bybit.NewClient().WithBaseURL(server.URL).WithAuth("key", "secret")
+	order := client.V5().Order()
+	symbol := bybit.SymbolV5BTCUSDT
+
+	t.Run("market order opens a position", func(t *testing.T) {
+		resp, err := order.CreateOrder(bybit.V5CreateOrderParam{
+			Category:    bybit.CategoryV5Linear,
+			Symbol:      symbol,
+			Side:        bybit.SideBuy,
+			OrderType:   bybit.OrderTypeMarket,
+			Qty:         "0.1",
+			OrderLinkID: testhelper.Ptr("open"),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Result.OrderID)
+
+		history, err := order.GetHistoryOrders(bybit.V5GetHistoryOrdersParam{Category: bybit.CategoryV5Linear, OrderLinkID: testhelper.Ptr("open")})
+		require.NoError(t, err)
+		require.Len(t, history.Result.List, 1)
+		assert.Equal(t, bybit.OrderStatusFilled, history.Result.List[0].OrderStatus)
+		assert.Equal(t, "30000", history.Result.List[0].AvgPrice)
+		assert.Equal(t, "3", history.Result.List[0].CumExecFee)
+
+		positions, err := client.V5().Position().GetPositionInfo(bybit.V5GetPositionInfoParam{Category: bybit.CategoryV5Linear, Symbol: &symbol})
+		require.NoError(t, err)
+		require.Len(t, positions.Result.List, 1)
+		assert.Equal(t, bybit.SideBuy, positions.Result.List[0].Side)
+		assert.Equal(t, "0.1", positions.Result.List[0].Size)
+		assert.Equal(t, "30000", positions.Result.List[0].AvgPrice)
+	})
+
+	t.Run("resting limit order fills when the price crosses", func(t *testing.T) {
+		resp, err := order.CreateOrder(bybit.V5CreateOrderParam{
+			Category:   bybit.CategoryV5Linear,
+			Symbol:     symbol,
+			Side:       bybit.SideSell,
+			OrderType:  bybit.OrderTypeLimit,
+			Qty:        "0.1",
+			Price:      testhelper.Ptr("31000"),
+			ReduceOnly: testhelper.Ptr(true),
+		})
+		require.NoError(t, err)
+
+		open, err := order.GetOpenOrders(bybit.V5GetOpenOrdersParam{Category: bybit.CategoryV5Linear, Symbol: &symbol})
+		require.NoError(t, err)
+		require.Len(t, open.Result.List, 1)
+		assert.Equal(t, resp.Result.OrderID, open.Result.List[0].OrderID)
+
+		_, err = order.AmendOrder(bybit.V5AmendOrderParam{
+			Category: bybit.CategoryV5Linear,
+			Symbol:   symbol,
+			OrderID:  &resp.Result.OrderID,
+			Price:    testhelper.Ptr("30500"),
+		})
+		require.NoError(t, err)
+
+		exchange.SetPrice("BTCUSDT", 30600)
+
+		executions, err := client.V5().Execution().GetExecutionList(bybit.V5GetExecutionParam{Category: bybit.CategoryV5Linear, OrderID: &resp.Result.OrderID})
+		require.NoError(t, err)
+		require.Len(t, executions.Result.List, 1)
+		assert.Equal(t, "30500", executions.Result.List[0].ExecPrice)
+		assert.True(t, executions.Result.List[0].IsMaker)
+		assert.Equal(t, "0.1", executions.Result.List[0].ClosedSize)
+
+		// 10000 - 3 taker fee + 50 realised
+		assert.InDelta(t, 10047, exchange.Balance("USDT"), 1e-9)
+		wallet, err := client.V5().Account().GetWalletBalance(bybit.AccountTypeV5UNIFIED, []bybit.Coin{bybit.CoinUSDT})
+		require.NoError(t, err)
+		assert.Equal(t, "10047", wallet.Result.List[0].TotalEquity)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		for _, price := range []string{"29000", "28000"} {
+			_, err := order.CreateOrder(bybit.V5CreateOrderParam{
+				Category:  bybit.CategoryV5Linear,
+				Symbol:    symbol,
+				Side:      bybit.SideBuy,
+				OrderType: bybit.OrderTypeLimit,
+				Qty:       "0.01",
+				Price:     testhelper.Ptr(price),
+			})
+			require.NoError(t, err)
+		}
+		open, err := order.GetOpenOrders(bybit.V5GetOpenOrdersParam{Category: bybit.CategoryV5Linear, Symbol: &symbol, Limit: testhelper.Ptr(1)})
+		require.NoError(t, err)
+		require.Len(t, open.Result.List, 1)
+		assert.Equal(t, "28000", open.Result.List[0].Price)
+		assert.NotEmpty(t, open.Result.NextPageCursor)
+
+		_, err = order.CancelOrder(bybit.V5CancelOrderParam{Category: bybit.CategoryV5Linear, Symbol: symbol, OrderID: &open.Result.List[0].OrderID})
+		require.NoError(t, err)
+		_, err = order.CancelOrder(bybit.V5CancelOrderParam{Category: bybit.CategoryV5Linear, Symbol: symbol, OrderID: &open.Result.List[0].OrderID})
+		assert.ErrorIs(t, err, bybit.ErrOrderNotFound)
+
+		all, err := order.CancelAllOrders(bybit.V5CancelAllOrdersParam{Category: bybit.CategoryV5Linear, Symbol: &symbol})
+		require.NoError(t, err)
+		assert.Len(t, all.Result.LinearInverseOption.List, 1)
+	})
+
+	t.Run("rejections", func(t *testing.T) {
+		_, err := order.CreateOrder(bybit.V5CreateOrderParam{
+			Category:   bybit.CategoryV5Linear,
+			Symbol:     symbol,
+			Side:       bybit.SideSell,
+			OrderType:  bybit.OrderTypeMarket,
+			Qty:        "1",
+			ReduceOnly: testhelper.Ptr(true),
+		})
+		assert.ErrorIs(t, err, bybit.ErrReduceOnlyViolation)
+
+		_, err = order.CreateOrder(bybit.V5CreateOrderParam{
+			Category:  bybit.CategoryV5Linear,
+			Symbol:    symbol,
+			Side:      bybit.SideBuy,
+			OrderType: bybit.OrderTypeMarket,
+			Qty:       "100",
+		})
+		assert.ErrorIs(t, err, bybit.ErrInsufficientBalance)
+	})
+
+	t.Run("spot", func(t *testing.T) {
+		_, err := order.CreateOrder(bybit.V5CreateOrderParam{
+			Category:  bybit.CategoryV5Spot,
+			Symbol:    symbol,
+			Side:      bybit.SideBuy,
+			OrderType: bybit.OrderTypeMarket,
+			Qty:       "0.01",
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 0.01, exchange.Balance("BTC"), 1e-9)
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		_, err := bybit.NewClient().WithBaseURL(server.URL).WithAuth("", "secret").V5().Position().GetPositionInfo(bybit.V5GetPositionInfoParam{Category: bybit.CategoryV5Linear, Symbol: &symbol})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,399 @@
+package testhelper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// WebsocketScript : a scripted Bybit WebSocket endpoint
+// The server answers auth, subscribe, unsubscribe and ping requests the way Bybit does and plays a
+// timeline of steps for each connection. The first connection plays the first timeline added with
+// Connection, the second one the second and so on, later connections replay the last timeline.
+//
+//	script := testhelper.NewWebsocketScript()
+//	script.Connection().
+//		WaitForSubscribe("orderbook.50.BTCUSDT").
+//		Send(testhelper.OrderBookMessage("orderbook.50.BTCUSDT", "snapshot", bids, asks, 1)).
+//		Send(testhelper.OrderBookMessage("orderbook.50.BTCUSDT", "delta", bids, nil, 2)).
+//		Disconnect()
+//	script.Connection().WaitForSubscribe("orderbook.50.BTCUSDT").Send(snapshot)
+//	server, teardown := testhelper.NewWebsocketServer(testhelper.WithWebsocketScriptOption("/v5/public/linear", script))
+type WebsocketScript struct {
+	key    string
+	secret string
+
+	mu          sync.Mutex
+	timelines   []*WebsocketTimeline
+	connections int
+	received    []string
+}
+
+// NewWebsocketScript :
+func NewWebsocketScript() *WebsocketScript {
+	return &WebsocketScript{}
+}
+
+// WithAuth : requires auth with the key, verifying the HMAC signature and expiry
+// Without it the endpoint behaves like a public stream and rejects auth requests.
+func (s *WebsocketScript) WithAuth(key, secret string) *WebsocketScript {
+	s.key = key
+	s.secret = secret
+
+	return s
+}
+
+// Connection : adds the timeline of the next connection
+func (s *WebsocketScript) Connection() *WebsocketTimeline {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	timeline := &WebsocketTimeline{}
+	s.timelines = append(s.timelines, timeline)
+	return timeline
+}
+
+// Connections : how many connections the server has accepted
+func (s *WebsocketScript) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.connections
+}
+
+// Received : every text message received, across connections
+func (s *WebsocketScript) Received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.received...)
+}
+
+// WebsocketTimeline : the steps played on one connection, in order
+type WebsocketTimeline struct {
+	steps []websocketStep
+}
+
+type websocketStep func(c *scriptedConnection) error
+
+// Send : pushes a message, []byte and string as they are, anything else as JSON
+func (t *WebsocketTimeline) Send(message interface{}) *WebsocketTimeline {
+	t.steps = append(t.steps, func(c *scriptedConnection) error {
+		var body []byte
+		switch m := message.(type) {
+		case []byte:
+			body = m
+		case string:
+			body = []byte(m)
+		default:
+			b, err := json.Marshal(m)
+			if err != nil {
+				return err
+			}
+			body = b
+		}
+		return c.write(body)
+	})
+	return t
+}
+
+// Wait : pauses the timeline
+func (t *WebsocketTimeline) Wait(d time.Duration) *WebsocketTimeline {
+	t.steps = append(t.steps, func(c *scriptedConnection) error {
+		select {
+		case <-time.After(d):
+			return nil
+		case <-c.done:
+			return errConnectionDone
+		}
+	})
+	return t
+}
+
+// WaitForAuth : pauses the timeline until the client has authenticated
+func (t *WebsocketTimeline) WaitForAuth() *WebsocketTimeline {
+	t.steps = append(t.steps, func(c *scriptedConnection) error {
+		return c.waitFor(func() bool { return c.authed })
+	})
+	return t
+}
+
+// WaitForSubscribe : pauses the timeline until the client has subscribed to the topic
+func (t *WebsocketTimeline) WaitForSubscribe(topic string) *WebsocketTimeline {
+	t.steps = append(t.steps, func(c *scriptedConnection) error {
+		return c.waitFor(func() bool { return c.topics[topic] })
+	})
+	return t
+}
+
+// Disconnect : drops the connection without a close frame, like a network failure
+func (t *WebsocketTimeline) Disconnect() *WebsocketTimeline {
+	t.steps = append(t.steps, func(c *scriptedConnection) error {
+		_ = c.conn.UnderlyingConn().Close()
+		return errConnectionDone
+	})
+	return t
+}
+
+// StopPong : stops answering ping frames and ping requests, so the client's read deadline expires
+func (t *WebsocketTimeline) StopPong() *WebsocketTimeline {
+	t.steps = append(t.steps, func(c *scriptedConnection) error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.noPong = true
+		return nil
+	})
+	return t
+}
+
+// OrderBookMessage : an orderbook snapshot or delta, levels are price and size pairs
+func OrderBookMessage(topic, messageType string, bids, asks [][2]string, updateID int64) []byte {
+	levels := func(in [][2]string) [][]string {
+		out := make([][]string, 0, len(in))
+		for _, l := range in {
+			out = append(out, []string{l[0], l[1]})
+		}
+		return out
+	}
+	now := time.Now().UnixMilli()
+	body, _ := json.Marshal(map[string]interface{}{
+		"topic": topic,
+		"type":  messageType,
+		"ts":    now,
+		"cts":   now,
+		"data": map[string]interface{}{
+			"s":   topic[strings.LastIndex(topic, ".")+1:],
+			"b":   levels(bids),
+			"a":   levels(asks),
+			"u":   updateID,
+			"seq": updateID,
+		},
+	})
+	return body
+}
+
+// PrivateMessage : a private stream event such as order, execution, position or wallet
+func PrivateMessage(topic string, data interface{}) []byte {
+	body, _ := json.Marshal(map[string]interface{}{
+		"id":           fmt.Sprintf("%s-%d", topic, time.Now().UnixNano()),
+		"topic":        topic,
+		"creationTime": time.Now().UnixMilli(),
+		"data":         data,
+	})
+	return body
+}
+
+// WithWebsocketScriptOption : serves the script on path of a NewWebsocketServer mux
+func WithWebsocketScriptOption(path string, script *WebsocketScript) func(*http.ServeMux) {
+	return func(mux *http.ServeMux) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			script.serve(conn)
+		})
+	}
+}
+
+var errConnectionDone = errors.New("connection done")
+
+type scriptedConnection struct {
+	script *WebsocketScript
+	conn   *websocket.Conn
+	id     string
+	done   chan struct{}
+
+	writeMu sync.Mutex
+
+	mu     sync.Mutex
+	authed bool
+	topics map[string]bool
+	noPong bool
+}
+
+func (s *WebsocketScript) serve(conn *websocket.Conn) {
+	s.mu.Lock()
+	s.connections++
+	var timeline *WebsocketTimeline
+	switch {
+	case len(s.timelines) == 0:
+		timeline = &WebsocketTimeline{}
+	case s.connections <= len(s.timelines):
+		timeline = s.timelines[s.connections-1]
+	default:
+		timeline = s.timelines[len(s.timelines)-1]
+	}
+	c := &scriptedConnection{
+		script: s,
+		conn:   conn,
+		id:     fmt.Sprintf("conn-%d", s.connections),
+		done:   make(chan struct{}),
+		topics: map[string]bool{},
+	}
+	s.mu.Unlock()
+
+	conn.SetPingHandler(func(data string) error {
+		c.mu.Lock()
+		noPong := c.noPong
+		c.mu.Unlock()
+		if noPong {
+			return nil
+		}
+		c.writeMu.Lock()
+		defer c.writeMu.Unlock()
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+
+	go func() {
+		for _, step := range timeline.steps {
+			if err := step(c); err != nil {
+				return
+			}
+		}
+	}()
+
+	defer conn.Close()
+	defer close(c.done)
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.received = append(s.received, string(message))
+		s.mu.Unlock()
+
+		if err := c.handle(message); err != nil {
+			return
+		}
+	}
+}
+
+func (c *scriptedConnection) write(body []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	return c.conn.WriteMessage(websocket.TextMessage, body)
+}
+
+// waitFor polls the connection state, returning when cond holds or the connection is gone
+func (c *scriptedConnection) waitFor(cond func() bool) error {
+	ticker := time.NewTicker(5 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		c.mu.Lock()
+		ok := cond()
+		c.mu.Unlock()
+		if ok {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-c.done:
+			return errConnectionDone
+		}
+	}
+}
+
+func (c *scriptedConnection) reply(op string, reqID string, success bool, retMsg string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"success": success,
+		"ret_msg": retMsg,
+		"conn_id": c.id,
+		"req_id":  reqID,
+		"op":      op,
+	})
+	if err != nil {
+		return err
+	}
+	return c.write(body)
+}
+
+func (c *scriptedConnection) handle(message []byte) error {
+	var req struct {
+		ReqID string            `json:"req_id"`
+		Op    string            `json:"op"`
+		Args  []json.RawMessage `json:"args"`
+	}
+	if err := json.Unmarshal(message, &req); err != nil {
+		return c.reply("", "", false, "Params Error")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	private := c.script.key != ""
+	switch req.Op {
+	case "ping":
+		if c.noPong {
+			return nil
+		}
+		op := "ping"
+		if private {
+			op = "pong"
+		}
+		return c.reply(op, req.ReqID, true, "pong")
+	case "auth":
+		if !private {
+			return c.reply("auth", req.ReqID, false, "auth is not supported on public streams")
+		}
+		if err := c.script.verifyAuth(req.Args); err != nil {
+			return c.reply("auth", req.ReqID, false, err.Error())
+		}
+		c.authed = true
+		return c.reply("auth", req.ReqID, true, "")
+	case "subscribe", "unsubscribe":
+		if private && !c.authed {
+			return c.reply(req.Op, req.ReqID, false, "Request not authorized")
+		}
+		for _, arg := range req.Args {
+			var topic string
+			if err := json.Unmarshal(arg, &topic); err != nil {
+				return c.reply(req.Op, req.ReqID, false, "Params Error")
+			}
+			c.topics[topic] = req.Op == "subscribe"
+		}
+		return c.reply(req.Op, req.ReqID, true, "")
+	default:
+		return c.reply(req.Op, req.ReqID, false, "Unsupported op")
+	}
+}
+
+// verifyAuth checks args of key, expires in milliseconds and the hex HMAC-SHA256 of GET/realtime<expires>
+func (s *WebsocketScript) verifyAuth(args []json.RawMessage) error {
+	if len(args) != 3 {
+		return errors.New("Params Error")
+	}
+	var (
+		key       string
+		expires   int64
+		signature string
+	)
+	if json.Unmarshal(args[0], &key) != nil || json.Unmarshal(args[1], &expires) != nil || json.Unmarshal(args[2], &signature) != nil {
+		return errors.New("Params Error")
+	}
+	if key != s.key {
+		return errors.New("Invalid apikey")
+	}
+	if expires < time.Now().UnixMilli() {
+		return errors.New("Request expired")
+	}
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	_, _ = fmt.Fprintf(mac, "GET/realtime%d", expires)
+	if !hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(signature)) {
+		return errors.New("Signature verification failed")
+	}
+	return nil
+}
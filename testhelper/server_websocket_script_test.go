@@ -0,0 +1,174 @@
+package testhelper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hirokisan/bybit/v2"
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketScript_Public(t *testing.T) {
+	topic := "orderbook.50.BTCUSDT"
+	snapshot := testhelper.OrderBookMessage(topic, "snapshot", [][2]string{{"30000", "1"}}, [][2]string{{"30001", "2"}}, 1)
+
+	script := testhelper.NewWebsocketScript()
+	script.Connection().
+		WaitForSubscribe(topic).
+		Send(snapshot).
+		Send(testhelper.OrderBookMessage(topic, "delta", [][2]string{{"30000", "0"}}, nil, 2)).
+		Disconnect()
+	script.Connection().
+		WaitForSubscribe(topic).
+		Send(snapshot)
+
+	category := bybit.CategoryV5Linear
+	server, teardown := testhelper.NewWebsocketServer(
+		testhelper.WithWebsocketScriptOption(bybit.V5WebsocketPublicPathFor(category), script),
+	)
+	defer teardown()
+
+	wsClient := bybit.NewWebsocketClient().WithBaseURL(server.URL)
+	connect := func() (<-chan bybit.V5WebsocketPublicOrderBookResponse, <-chan error) {
+		svc, err := wsClient.V5().Public(category)
+		require.NoError(t, err)
+
+		books := make(chan bybit.V5WebsocketPublicOrderBookResponse, 10)
+		_, err = svc.SubscribeOrderBook(
+			bybit.V5WebsocketPublicOrderBookParamKey{Depth: 50, Symbol: bybit.SymbolV5BTCUSDT},
+			func(resp bybit.V5WebsocketPublicOrderBookResponse) error {
+				books <- resp
+				return nil
+			},
+		)
+		require.NoError(t, err)
+
+		errs := make(chan error, 1)
+		go func() {
+			for {
+				if err := svc.Run(); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+		t.Cleanup(func() { _ = svc.Close() })
+		return books, errs
+	}
+
+	books, errs := connect()
+	first := <-books
+	assert.Equal(t, "snapshot", first.Type)
+	require.Len(t, first.Data.Bids, 1)
+	assert.Equal(t, "30000", first.Data.Bids[0].Price)
+	second := <-books
+	assert.Equal(t, "delta", second.Type)
+	assert.Equal(t, 2, second.Data.UpdateID)
+
+	select {
+	case err := <-errs:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection not dropped")
+	}
+
+	books, _ = connect()
+	assert.Equal(t, "snapshot", (<-books).Type)
+	assert.Equal(t, 2, script.Connections())
+	assert.Contains(t, script.Received(), `{"op":"subscribe","args":["orderbook.50.BTCUSDT"]}`)
+}
+
+func TestWebsocketScript_Private(t *testing.T) {
+	script := testhelper.NewWebsocketScript().WithAuth("key", "secret")
+	script.Connection().
+		WaitForAuth().
+		WaitForSubscribe("order").
+		Send(testhelper.PrivateMessage("order", []map[string]interface{}{
+			{"orderId": "1", "symbol": "BTCUSDT", "orderStatus": "New", "category": "linear"},
+		}))
+
+	server, teardown := testhelper.NewWebsocketServer(
+		testhelper.WithWebsocketScriptOption(bybit.V5WebsocketPrivatePath, script),
+	)
+	defer teardown()
+
+	t.Run("order event after auth", func(t *testing.T) {
+		svc, err := bybit.NewWebsocketClient().WithBaseURL(server.URL).WithAuth("key", "secret").V5().Private()
+		require.NoError(t, err)
+		defer svc.Close()
+		require.NoError(t, svc.Subscribe())
+
+		orders := make(chan bybit.V5WebsocketPrivateOrderResponse, 1)
+		_, err = svc.SubscribeOrder(func(resp bybit.V5WebsocketPrivateOrderResponse) error {
+			orders <- resp
+			return nil
+		})
+		require.NoError(t, err)
+		go func() {
+			for svc.Run() == nil {
+			}
+		}()
+
+		select {
+		case resp := <-orders:
+			require.Len(t, resp.Data, 1)
+			assert.Equal(t, "1", resp.Data[0].OrderID)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no order event")
+		}
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		svc, err := bybit.NewWebsocketClient().WithBaseURL(server.URL).WithAuth("key", "wrong").V5().Private()
+		require.NoError(t, err)
+		defer svc.Close()
+		require.NoError(t, svc.Subscribe())
+
+		err = svc.Run()
+		assert.EqualError(t, err, "auth failed: Signature verification failed")
+	})
+}
+
+func TestWebsocketScript_StopPong(t *testing.T) {
+	script := testhelper.NewWebsocketScript()
+	script.Connection().Wait(50 * time.Millisecond).StopPong()
+
+	server, teardown := testhelper.NewWebsocketServer(testhelper.WithWebsocketScriptOption("/ws", script))
+	defer teardown()
+
+	conn, _, err := websocket.DefaultDialer.Dial(server.URL+"/ws", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	pongs := make(chan string, 10)
+	conn.SetPongHandler(func(string) error {
+		pongs <- "frame"
+		return nil
+	})
+	go func() {
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			pongs <- string(message)
+		}
+	}()
+
+	require.NoError(t, conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second)))
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"op":"ping"}`)))
+	assert.Equal(t, "frame", <-pongs)
+	assert.Contains(t, <-pongs, `"ret_msg":"pong"`)
+
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second)))
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"op":"ping"}`)))
+	select {
+	case pong := <-pongs:
+		t.Fatalf("unexpected pong %s", pong)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -11,7 +11,7 @@ import (
 
 func TestUpdateSyncTimeDelta(t *testing.T) {
 	// given
-	c := &Client{}
+	c := NewClient()
 	remoteServerTimeRaw := "1688721231460000000"
 	localTimestampNanoseconds := int64(1688721231560000000)
 	expectedNsDelta := int64(100000000)
@@ -23,7 +23,7 @@ func TestUpdateSyncTimeDelta(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	assert.Equal(t, expectedNsDelta, c.syncTimeDeltaNanoSeconds)
+	assert.Equal(t, time.Duration(expectedNsDelta), c.ServerTimeStatus().Offset)
 
 	timestampMsDelta := int64(math.Abs(float64(c.getTimestamp()) - float64(nowTimestampMs)))
 	assert.Less(t, timestampMsDelta, recvWindowMs)
@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -12,12 +13,19 @@ import (
 // V5AccountServiceI :
 type V5AccountServiceI interface {
 	GetWalletBalance(AccountTypeV5, []Coin) (*V5GetWalletBalanceResponse, error)
+	GetWalletBalanceWithContext(context.Context, AccountTypeV5, []Coin) (*V5GetWalletBalanceResponse, error)
 	SetCollateralCoin(V5SetCollateralCoinParam) (*V5SetCollateralCoinResponse, error)
+	SetCollateralCoinWithContext(context.Context, V5SetCollateralCoinParam) (*V5SetCollateralCoinResponse, error)
 	BatchSetCollateralCoin(V5BatchSetCollateralCoinParam) (*V5BatchSetCollateralCoinResponse, error)
+	BatchSetCollateralCoinWithContext(context.Context, V5BatchSetCollateralCoinParam) (*V5BatchSetCollateralCoinResponse, error)
 	GetCollateralInfo(V5GetCollateralInfoParam) (*V5GetCollateralInfoResponse, error)
+	GetCollateralInfoWithContext(context.Context, V5GetCollateralInfoParam) (*V5GetCollateralInfoResponse, error)
 	GetAccountInfo() (*V5GetAccountInfoResponse, error)
+	GetAccountInfoWithContext(context.Context) (*V5GetAccountInfoResponse, error)
 	GetTransactionLog(V5GetTransactionLogParam) (*V5GetTransactionLogResponse, error)
+	GetTransactionLogWithContext(context.Context, V5GetTransactionLogParam) (*V5GetTransactionLogResponse, error)
 	GetFeeRate(V5GetFeeRateParam) (*V5GetFeeRateResponse, error)
+	GetFeeRateWithContext(context.Context, V5GetFeeRateParam) (*V5GetFeeRateResponse, error)
 }
 
 // V5AccountService :
@@ -84,6 +92,11 @@ type V5WalletBalanceList struct {
 // If not passed, it returns non-zero asset info
 // You can pass multiple coins to query, separated by comma. "USDT,USDC".
 func (s *V5AccountService) GetWalletBalance(at AccountTypeV5, coins []Coin) (*V5GetWalletBalanceResponse, error) {
+	return s.GetWalletBalanceWithContext(context.Background(), at, coins)
+}
+
+// GetWalletBalanceWithContext :
+func (s *V5AccountService) GetWalletBalanceWithContext(ctx context.Context, at AccountTypeV5, coins []Coin) (*V5GetWalletBalanceResponse, error) {
 	switch at {
 	case AccountTypeV5UNIFIED, AccountTypeV5CONTRACT, AccountTypeV5SPOT:
 	default:
@@ -103,7 +116,7 @@ func (s *V5AccountService) GetWalletBalance(at AccountTypeV5, coins []Coin) (*V5
 		query.Add("coin", strings.Join(coinsStr, ","))
 	}
 
-	if err := s.client.getV5Privately("/v5/account/wallet-balance", query, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/account/wallet-balance", nil, query, &res); err != nil {
 		return nil, err
 	}
 
@@ -129,6 +142,11 @@ type V5SetCollateralCoinResponse struct {
 
 // SetCollateralCoin :
 func (s *V5AccountService) SetCollateralCoin(param V5SetCollateralCoinParam) (*V5SetCollateralCoinResponse, error) {
+	return s.SetCollateralCoinWithContext(context.Background(), param)
+}
+
+// SetCollateralCoinWithContext :
+func (s *V5AccountService) SetCollateralCoinWithContext(ctx context.Context, param V5SetCollateralCoinParam) (*V5SetCollateralCoinResponse, error) {
 	var res V5SetCollateralCoinResponse
 
 	body, err := json.Marshal(param)
@@ -136,7 +154,7 @@ func (s *V5AccountService) SetCollateralCoin(param V5SetCollateralCoinParam) (*V
 		return nil, err
 	}
 
-	if err := s.client.postV5JSON("/v5/account/set-collateral-switch", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/account/set-collateral-switch", param, body, &res); err != nil {
 		return nil, err
 	}
 
@@ -161,6 +179,11 @@ type V5BatchSetCollateralCoinResponse struct {
 
 // BatchSetCollateralCoin :
 func (s *V5AccountService) BatchSetCollateralCoin(param V5BatchSetCollateralCoinParam) (*V5BatchSetCollateralCoinResponse, error) {
+	return s.BatchSetCollateralCoinWithContext(context.Background(), param)
+}
+
+// BatchSetCollateralCoinWithContext :
+func (s *V5AccountService) BatchSetCollateralCoinWithContext(ctx context.Context, param V5BatchSetCollateralCoinParam) (*V5BatchSetCollateralCoinResponse, error) {
 	var res V5BatchSetCollateralCoinResponse
 
 	body, err := json.Marshal(param)
@@ -168,7 +191,7 @@ func (s *V5AccountService) BatchSetCollateralCoin(param V5BatchSetCollateralCoin
 		return nil, err
 	}
 
-	if err = s.client.postV5JSON("/v5/account/set-collateral-switch-batch", body, &res); err != nil {
+	if err = s.client.postV5JSON(ctx, "/v5/account/set-collateral-switch-batch", param, body, &res); err != nil {
 		return nil, err
 	}
 
@@ -210,6 +233,11 @@ type V5GetCollateralInfoList struct {
 
 // GetCollateralInfo :
 func (s *V5AccountService) GetCollateralInfo(param V5GetCollateralInfoParam) (*V5GetCollateralInfoResponse, error) {
+	return s.GetCollateralInfoWithContext(context.Background(), param)
+}
+
+// GetCollateralInfoWithContext :
+func (s *V5AccountService) GetCollateralInfoWithContext(ctx context.Context, param V5GetCollateralInfoParam) (*V5GetCollateralInfoResponse, error) {
 	var res V5GetCollateralInfoResponse
 
 	queryString, err := query.Values(param)
@@ -217,7 +245,7 @@ func (s *V5AccountService) GetCollateralInfo(param V5GetCollateralInfoParam) (*V
 		return nil, err
 	}
 
-	if err = s.client.getV5Privately("/v5/account/collateral-info", queryString, &res); err != nil {
+	if err = s.client.getV5Privately(ctx, "/v5/account/collateral-info", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -239,12 +267,17 @@ type V5AccountInfoResult struct {
 
 // GetAccountInfo :
 func (s *V5AccountService) GetAccountInfo() (*V5GetAccountInfoResponse, error) {
+	return s.GetAccountInfoWithContext(context.Background())
+}
+
+// GetAccountInfoWithContext :
+func (s *V5AccountService) GetAccountInfoWithContext(ctx context.Context) (*V5GetAccountInfoResponse, error) {
 	var (
 		res   V5GetAccountInfoResponse
 		query = make(url.Values)
 	)
 
-	if err := s.client.getV5Privately("/v5/account/info", query, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/account/info", nil, query, &res); err != nil {
 		return nil, err
 	}
 
@@ -304,6 +337,11 @@ type V5GetTransactionLogItem struct {
 
 // GetTransactionLog :
 func (s *V5AccountService) GetTransactionLog(param V5GetTransactionLogParam) (*V5GetTransactionLogResponse, error) {
+	return s.GetTransactionLogWithContext(context.Background(), param)
+}
+
+// GetTransactionLogWithContext :
+func (s *V5AccountService) GetTransactionLogWithContext(ctx context.Context, param V5GetTransactionLogParam) (*V5GetTransactionLogResponse, error) {
 	var res V5GetTransactionLogResponse
 
 	queryString, err := query.Values(param)
@@ -311,7 +349,7 @@ func (s *V5AccountService) GetTransactionLog(param V5GetTransactionLogParam) (*V
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/account/transaction-log", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/account/transaction-log", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -351,6 +389,11 @@ type V5GetFeeRateItem struct {
 
 // GetFeeRate :
 func (s *V5AccountService) GetFeeRate(param V5GetFeeRateParam) (*V5GetFeeRateResponse, error) {
+	return s.GetFeeRateWithContext(context.Background(), param)
+}
+
+// GetFeeRateWithContext :
+func (s *V5AccountService) GetFeeRateWithContext(ctx context.Context, param V5GetFeeRateParam) (*V5GetFeeRateResponse, error) {
 	var res V5GetFeeRateResponse
 
 	queryString, err := query.Values(param)
@@ -358,7 +401,7 @@ func (s *V5AccountService) GetFeeRate(param V5GetFeeRateParam) (*V5GetFeeRateRes
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/account/fee-rate", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/account/fee-rate", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -13,17 +14,29 @@ import (
 // V5AssetServiceI :
 type V5AssetServiceI interface {
 	CreateInternalTransfer(V5CreateInternalTransferParam) (*V5CreateInternalTransferResponse, error)
+	CreateInternalTransferWithContext(context.Context, V5CreateInternalTransferParam) (*V5CreateInternalTransferResponse, error)
 	GetInternalTransferRecords(V5GetInternalTransferRecordsParam) (*V5GetInternalTransferRecordsResponse, error)
+	GetInternalTransferRecordsWithContext(context.Context, V5GetInternalTransferRecordsParam) (*V5GetInternalTransferRecordsResponse, error)
 	CreateUniversalTransfer(V5CreateUniversalTransferParam) (*V5CreateUniversalTransferResponse, error)
+	CreateUniversalTransferWithContext(context.Context, V5CreateUniversalTransferParam) (*V5CreateUniversalTransferResponse, error)
 	GetUniversalTransferRecords(V5GetUniversalTransferRecordsParam) (*V5GetUniversalTransferRecordsResponse, error)
+	GetUniversalTransferRecordsWithContext(context.Context, V5GetUniversalTransferRecordsParam) (*V5GetUniversalTransferRecordsResponse, error)
 	GetDepositRecords(V5GetDepositRecordsParam) (*V5GetDepositRecordsResponse, error)
+	GetDepositRecordsWithContext(context.Context, V5GetDepositRecordsParam) (*V5GetDepositRecordsResponse, error)
 	GetSubDepositRecords(V5GetSubDepositRecordsParam) (*V5GetSubDepositRecordsResponse, error)
+	GetSubDepositRecordsWithContext(context.Context, V5GetSubDepositRecordsParam) (*V5GetSubDepositRecordsResponse, error)
 	GetInternalDepositRecords(V5GetInternalDepositRecordsParam) (*V5GetInternalDepositRecordsResponse, error)
+	GetInternalDepositRecordsWithContext(context.Context, V5GetInternalDepositRecordsParam) (*V5GetInternalDepositRecordsResponse, error)
 	GetMasterDepositAddress(V5GetMasterDepositAddressParam) (*V5GetMasterDepositAddressResponse, error)
+	GetMasterDepositAddressWithContext(context.Context, V5GetMasterDepositAddressParam) (*V5GetMasterDepositAddressResponse, error)
 	GetWithdrawalRecords(V5GetWithdrawalRecordsParam) (*V5GetWithdrawalRecordsResponse, error)
+	GetWithdrawalRecordsWithContext(context.Context, V5GetWithdrawalRecordsParam) (*V5GetWithdrawalRecordsResponse, error)
 	GetCoinInfo(V5GetCoinInfoParam) (*V5GetCoinInfoResponse, error)
+	GetCoinInfoWithContext(context.Context, V5GetCoinInfoParam) (*V5GetCoinInfoResponse, error)
 	GetAllCoinsBalance(V5GetAllCoinsBalanceParam) (*V5GetAllCoinsBalanceResponse, error)
+	GetAllCoinsBalanceWithContext(context.Context, V5GetAllCoinsBalanceParam) (*V5GetAllCoinsBalanceResponse, error)
 	Withdraw(param V5WithdrawParam) (*V5WithdrawResponse, error)
+	WithdrawWithContext(ctx context.Context, param V5WithdrawParam) (*V5WithdrawResponse, error)
 }
 
 // V5AssetService :
@@ -74,6 +87,11 @@ type V5CreateInternalTransferResult struct {
 
 // CreateInternalTransfer :
 func (s *V5AssetService) CreateInternalTransfer(param V5CreateInternalTransferParam) (*V5CreateInternalTransferResponse, error) {
+	return s.CreateInternalTransferWithContext(context.Background(), param)
+}
+
+// CreateInternalTransferWithContext :
+func (s *V5AssetService) CreateInternalTransferWithContext(ctx context.Context, param V5CreateInternalTransferParam) (*V5CreateInternalTransferResponse, error) {
 	var res V5CreateInternalTransferResponse
 
 	if err := param.validate(); err != nil {
@@ -85,7 +103,7 @@ func (s *V5AssetService) CreateInternalTransfer(param V5CreateInternalTransferPa
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/asset/transfer/inter-transfer", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/asset/transfer/inter-transfer", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -131,6 +149,11 @@ type V5GetInternalTransferRecordsItem struct {
 
 // GetInternalTransferRecords :
 func (s *V5AssetService) GetInternalTransferRecords(param V5GetInternalTransferRecordsParam) (*V5GetInternalTransferRecordsResponse, error) {
+	return s.GetInternalTransferRecordsWithContext(context.Background(), param)
+}
+
+// GetInternalTransferRecordsWithContext :
+func (s *V5AssetService) GetInternalTransferRecordsWithContext(ctx context.Context, param V5GetInternalTransferRecordsParam) (*V5GetInternalTransferRecordsResponse, error) {
 	var res V5GetInternalTransferRecordsResponse
 
 	queryString, err := query.Values(param)
@@ -138,7 +161,7 @@ func (s *V5AssetService) GetInternalTransferRecords(param V5GetInternalTransferR
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/transfer/query-inter-transfer-list", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/transfer/query-inter-transfer-list", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -182,6 +205,11 @@ type V5CreateUniversalTransferResult struct {
 }
 
 func (s *V5AssetService) CreateUniversalTransfer(param V5CreateUniversalTransferParam) (*V5CreateUniversalTransferResponse, error) {
+	return s.CreateUniversalTransferWithContext(context.Background(), param)
+}
+
+// CreateUniversalTransferWithContext :
+func (s *V5AssetService) CreateUniversalTransferWithContext(ctx context.Context, param V5CreateUniversalTransferParam) (*V5CreateUniversalTransferResponse, error) {
 	var res V5CreateUniversalTransferResponse
 
 	if err := param.validate(); err != nil {
@@ -193,7 +221,7 @@ func (s *V5AssetService) CreateUniversalTransfer(param V5CreateUniversalTransfer
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/asset/transfer/universal-transfer", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/asset/transfer/universal-transfer", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -235,6 +263,11 @@ type V5GetUniversalTransferRecordsItem struct {
 }
 
 func (s *V5AssetService) GetUniversalTransferRecords(param V5GetUniversalTransferRecordsParam) (*V5GetUniversalTransferRecordsResponse, error) {
+	return s.GetUniversalTransferRecordsWithContext(context.Background(), param)
+}
+
+// GetUniversalTransferRecordsWithContext :
+func (s *V5AssetService) GetUniversalTransferRecordsWithContext(ctx context.Context, param V5GetUniversalTransferRecordsParam) (*V5GetUniversalTransferRecordsResponse, error) {
 	var res V5GetUniversalTransferRecordsResponse
 
 	queryString, err := query.Values(param)
@@ -242,7 +275,7 @@ func (s *V5AssetService) GetUniversalTransferRecords(param V5GetUniversalTransfe
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/transfer/query-universal-transfer-list", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/transfer/query-universal-transfer-list", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -291,6 +324,11 @@ type V5GetDepositRecordsRow struct {
 
 // GetDepositRecords :
 func (s *V5AssetService) GetDepositRecords(param V5GetDepositRecordsParam) (*V5GetDepositRecordsResponse, error) {
+	return s.GetDepositRecordsWithContext(context.Background(), param)
+}
+
+// GetDepositRecordsWithContext :
+func (s *V5AssetService) GetDepositRecordsWithContext(ctx context.Context, param V5GetDepositRecordsParam) (*V5GetDepositRecordsResponse, error) {
 	var res V5GetDepositRecordsResponse
 
 	queryString, err := query.Values(param)
@@ -298,7 +336,7 @@ func (s *V5AssetService) GetDepositRecords(param V5GetDepositRecordsParam) (*V5G
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/deposit/query-record", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/deposit/query-record", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -349,6 +387,11 @@ type V5GetSubDepositRecordsRow struct {
 
 // GetSubDepositRecords :
 func (s *V5AssetService) GetSubDepositRecords(param V5GetSubDepositRecordsParam) (*V5GetSubDepositRecordsResponse, error) {
+	return s.GetSubDepositRecordsWithContext(context.Background(), param)
+}
+
+// GetSubDepositRecordsWithContext :
+func (s *V5AssetService) GetSubDepositRecordsWithContext(ctx context.Context, param V5GetSubDepositRecordsParam) (*V5GetSubDepositRecordsResponse, error) {
 	var res V5GetSubDepositRecordsResponse
 
 	queryString, err := query.Values(param)
@@ -356,7 +399,7 @@ func (s *V5AssetService) GetSubDepositRecords(param V5GetSubDepositRecordsParam)
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/deposit/query-sub-member-record", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/deposit/query-sub-member-record", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -400,6 +443,11 @@ type V5GetInternalDepositRecordsRow struct {
 
 // GetInternalDepositRecords :
 func (s *V5AssetService) GetInternalDepositRecords(param V5GetInternalDepositRecordsParam) (*V5GetInternalDepositRecordsResponse, error) {
+	return s.GetInternalDepositRecordsWithContext(context.Background(), param)
+}
+
+// GetInternalDepositRecordsWithContext :
+func (s *V5AssetService) GetInternalDepositRecordsWithContext(ctx context.Context, param V5GetInternalDepositRecordsParam) (*V5GetInternalDepositRecordsResponse, error) {
 	var res V5GetInternalDepositRecordsResponse
 
 	queryString, err := query.Values(param)
@@ -407,7 +455,7 @@ func (s *V5AssetService) GetInternalDepositRecords(param V5GetInternalDepositRec
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/deposit/query-internal-record", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/deposit/query-internal-record", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -449,6 +497,11 @@ type V5GetMasterDepositAddressResponse struct {
 }
 
 func (s *V5AssetService) GetMasterDepositAddress(param V5GetMasterDepositAddressParam) (*V5GetMasterDepositAddressResponse, error) {
+	return s.GetMasterDepositAddressWithContext(context.Background(), param)
+}
+
+// GetMasterDepositAddressWithContext :
+func (s *V5AssetService) GetMasterDepositAddressWithContext(ctx context.Context, param V5GetMasterDepositAddressParam) (*V5GetMasterDepositAddressResponse, error) {
 	var res V5GetMasterDepositAddressResponse
 
 	queryString, err := query.Values(param)
@@ -456,7 +509,7 @@ func (s *V5AssetService) GetMasterDepositAddress(param V5GetMasterDepositAddress
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/deposit/query-address", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/deposit/query-address", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -507,6 +560,11 @@ type V5GetWithdrawalRecordsRow struct {
 
 // GetWithdrawalRecords :
 func (s *V5AssetService) GetWithdrawalRecords(param V5GetWithdrawalRecordsParam) (*V5GetWithdrawalRecordsResponse, error) {
+	return s.GetWithdrawalRecordsWithContext(context.Background(), param)
+}
+
+// GetWithdrawalRecordsWithContext :
+func (s *V5AssetService) GetWithdrawalRecordsWithContext(ctx context.Context, param V5GetWithdrawalRecordsParam) (*V5GetWithdrawalRecordsResponse, error) {
 	var res V5GetWithdrawalRecordsResponse
 
 	queryString, err := query.Values(param)
@@ -514,7 +572,7 @@ func (s *V5AssetService) GetWithdrawalRecords(param V5GetWithdrawalRecordsParam)
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/withdraw/query-record", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/withdraw/query-record", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -568,6 +626,11 @@ type V5GetCoinInfoChain struct {
 
 // GetCoinInfo :
 func (s *V5AssetService) GetCoinInfo(param V5GetCoinInfoParam) (*V5GetCoinInfoResponse, error) {
+	return s.GetCoinInfoWithContext(context.Background(), param)
+}
+
+// GetCoinInfoWithContext :
+func (s *V5AssetService) GetCoinInfoWithContext(ctx context.Context, param V5GetCoinInfoParam) (*V5GetCoinInfoResponse, error) {
 	var res V5GetCoinInfoResponse
 
 	queryString, err := query.Values(param)
@@ -575,7 +638,7 @@ func (s *V5AssetService) GetCoinInfo(param V5GetCoinInfoParam) (*V5GetCoinInfoRe
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/coin/query-info", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/coin/query-info", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -614,6 +677,11 @@ type V5GetAllCoinsBalanceBalance struct {
 // GetAllCoinsBalance :
 // https://bybit-exchange.github.io/docs/v5/asset/all-balance
 func (s *V5AssetService) GetAllCoinsBalance(param V5GetAllCoinsBalanceParam) (*V5GetAllCoinsBalanceResponse, error) {
+	return s.GetAllCoinsBalanceWithContext(context.Background(), param)
+}
+
+// GetAllCoinsBalanceWithContext :
+func (s *V5AssetService) GetAllCoinsBalanceWithContext(ctx context.Context, param V5GetAllCoinsBalanceParam) (*V5GetAllCoinsBalanceResponse, error) {
 	var res V5GetAllCoinsBalanceResponse
 
 	queryString, err := query.Values(param)
@@ -629,7 +697,7 @@ func (s *V5AssetService) GetAllCoinsBalance(param V5GetAllCoinsBalanceParam) (*V
 		queryString.Set("coin", strings.Join(coinsToQuery, ","))
 	}
 
-	if err := s.client.getV5Privately("/v5/asset/transfer/query-account-coins-balance", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/asset/transfer/query-account-coins-balance", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -660,6 +728,11 @@ type V5WithdrawParam struct {
 }
 
 func (s *V5AssetService) Withdraw(param V5WithdrawParam) (*V5WithdrawResponse, error) {
+	return s.WithdrawWithContext(context.Background(), param)
+}
+
+// WithdrawWithContext :
+func (s *V5AssetService) WithdrawWithContext(ctx context.Context, param V5WithdrawParam) (*V5WithdrawResponse, error) {
 	var res V5WithdrawResponse
 
 	body, err := json.Marshal(param)
@@ -667,7 +740,7 @@ func (s *V5AssetService) Withdraw(param V5WithdrawParam) (*V5WithdrawResponse, e
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/asset/withdraw/create", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/asset/withdraw/create", param, body, &res); err != nil {
 		return &res, err
 	}
 
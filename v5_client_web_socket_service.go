@@ -1,14 +1,10 @@
 package bybit
 
-import (
-	"github.com/gorilla/websocket"
-)
-
 // V5WebsocketServiceI :
 type V5WebsocketServiceI interface {
-	Public(CategoryV5) (V5WebsocketPublicService, error)
-	Private() (V5WebsocketPrivateService, error)
-	Trade() (V5WebsocketTradeService, error)
+	Public(CategoryV5) (V5WebsocketPublicServiceI, error)
+	Private() (V5WebsocketPrivateServiceI, error)
+	Trade() (V5WebsocketTradeServiceI, error)
 }
 
 // V5WebsocketService :
@@ -18,14 +14,7 @@ type V5WebsocketService struct {
 
 // Public :
 func (s *V5WebsocketService) Public(category CategoryV5) (V5WebsocketPublicServiceI, error) {
-	url := s.client.baseURL + V5WebsocketPublicPathFor(category)
-	var c *websocket.Conn
-	var err error
-	if s.client.dialer != nil {
-		c, _, err = s.client.dialer.Dial(url, nil)
-	} else {
-		c, _, err = websocket.DefaultDialer.Dial(url, nil)
-	}
+	c, err := s.client.dial(s.client.publicURL(), V5WebsocketPublicPathFor(category), WebsocketServicePublic, category)
 	if err != nil {
 		return nil, err
 	}
@@ -44,14 +33,7 @@ func (s *V5WebsocketService) Public(category CategoryV5) (V5WebsocketPublicServi
 
 // Private :
 func (s *V5WebsocketService) Private() (V5WebsocketPrivateServiceI, error) {
-	url := s.client.baseURL + V5WebsocketPrivatePath
-	var c *websocket.Conn
-	var err error
-	if s.client.dialer != nil {
-		c, _, err = s.client.dialer.Dial(url, nil)
-	} else {
-		c, _, err = websocket.DefaultDialer.Dial(url, nil)
-	}
+	c, err := s.client.dial(s.client.baseURL, V5WebsocketPrivatePath, WebsocketServicePrivate, "")
 	if err != nil {
 		return nil, err
 	}
@@ -67,14 +49,7 @@ func (s *V5WebsocketService) Private() (V5WebsocketPrivateServiceI, error) {
 
 // Trade :
 func (s *V5WebsocketService) Trade() (V5WebsocketTradeServiceI, error) {
-	url := s.client.baseURL + V5WebsocketTradePath
-	var c *websocket.Conn
-	var err error
-	if s.client.dialer != nil {
-		c, _, err = s.client.dialer.Dial(url, nil)
-	} else {
-		c, _, err = websocket.DefaultDialer.Dial(url, nil)
-	}
+	c, err := s.client.dial(s.client.baseURL, V5WebsocketTradePath, WebsocketServiceTrade, "")
 	if err != nil {
 		return nil, err
 	}
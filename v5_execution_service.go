@@ -1,10 +1,15 @@
 package bybit
 
-import "github.com/google/go-querystring/query"
+import (
+	"context"
+
+	"github.com/google/go-querystring/query"
+)
 
 // V5ExecutionServiceI :
 type V5ExecutionServiceI interface {
 	GetExecutionList(V5GetExecutionParam) (*V5GetExecutionListResponse, error)
+	GetExecutionListWithContext(context.Context, V5GetExecutionParam) (*V5GetExecutionListResponse, error)
 }
 
 // V5ExecutionService :
@@ -71,6 +76,11 @@ type V5GetExecutionListItem struct {
 }
 
 func (s *V5ExecutionService) GetExecutionList(param V5GetExecutionParam) (*V5GetExecutionListResponse, error) {
+	return s.GetExecutionListWithContext(context.Background(), param)
+}
+
+// GetExecutionListWithContext :
+func (s *V5ExecutionService) GetExecutionListWithContext(ctx context.Context, param V5GetExecutionParam) (*V5GetExecutionListResponse, error) {
 	var res V5GetExecutionListResponse
 
 	queryString, err := query.Values(param)
@@ -78,7 +88,7 @@ func (s *V5ExecutionService) GetExecutionList(param V5GetExecutionParam) (*V5Get
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/execution/list", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/execution/list", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,403 @@
+package bybit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// DefaultKlinePageSize : the most bars GetKline returns per call
+const DefaultKlinePageSize = 1000
+
+// klineRateLimitGroup is the RateLimiter bucket of the market endpoints, 120 requests per second by default
+const klineRateLimitGroup = "market"
+
+// KlineSeries : the price series a KlineDownloader fetches
+type KlineSeries string
+
+const (
+	// KlineSeriesTrade : /v5/market/kline
+	KlineSeriesTrade = KlineSeries("kline")
+	// KlineSeriesMarkPrice : /v5/market/mark-price-kline
+	KlineSeriesMarkPrice = KlineSeries("mark-price-kline")
+	// KlineSeriesIndexPrice : /v5/market/index-price-kline
+	KlineSeriesIndexPrice = KlineSeries("index-price-kline")
+	// KlineSeriesPremiumIndexPrice : /v5/market/premium-index-price-kline
+	KlineSeriesPremiumIndexPrice = KlineSeries("premium-index-price-kline")
+)
+
+// Kline : a bar of any series, Volume and Turnover are only set for KlineSeriesTrade
+type Kline struct {
+	Start    time.Time
+	Open     string
+	High     string
+	Low      string
+	Close    string
+	Volume   string
+	Turnover string
+}
+
+// KlineGap : consecutive bars missing from the response, From and To are bar start times
+type KlineGap struct {
+	From    time.Time
+	To      time.Time
+	Missing int
+}
+
+// KlineRequest : the bars whose start time is within [Start, End]
+type KlineRequest struct {
+	Series   KlineSeries
+	Category CategoryV5
+	Symbol   SymbolV5
+	Interval Interval
+	Start    time.Time
+	End      time.Time
+}
+
+// Done : whether nothing is left to download, as for the Checkpoint of the last batch
+func (r KlineRequest) Done() bool {
+	return r.End.Before(r.Start)
+}
+
+// KlineBatch : the bars of one request in chronological order
+type KlineBatch struct {
+	Klines []Kline
+	Gaps   []KlineGap
+	// Checkpoint is what is left after this batch, pass it to Download to resume
+	Checkpoint KlineRequest
+}
+
+// KlineDownloader : downloads kline series over arbitrary time ranges
+// The range is split into requests of up to the page size, which are fetched concurrently
+// and handed over in order. Every request waits for the rate limiter first.
+// Bybit limits market endpoints per ip to 600 requests in 5 seconds, the "market" group of the limiter
+// defaults to that budget since these responses carry no X-Bapi-Limit to follow.
+// The limiter only counts the requests of downloaders sharing it, the client does not rate limit
+// its own public market calls, so leave room for them when they run alongside a download.
+type KlineDownloader struct {
+	market      V5MarketServiceI
+	limiter     *RateLimiter
+	concurrency int
+	pageSize    int
+	backward    bool
+	now         func() time.Time
+}
+
+// NewKlineDownloader : uses the RateLimiter of the client when it has one, a private one otherwise
+func NewKlineDownloader(market V5MarketServiceI) *KlineDownloader {
+	limiter := NewRateLimiter()
+	if service, ok := market.(*V5MarketService); ok && service.client.rateLimiter != nil {
+		limiter = service.client.rateLimiter
+	}
+	return &KlineDownloader{
+		market:      market,
+		limiter:     limiter,
+		concurrency: 4,
+		pageSize:    DefaultKlinePageSize,
+		now:         time.Now,
+	}
+}
+
+// WithConcurrency : how many requests may be in flight, 4 by default
+func (d *KlineDownloader) WithConcurrency(n int) *KlineDownloader {
+	if n > 0 {
+		d.concurrency = n
+	}
+
+	return d
+}
+
+// WithRateLimiter : shares a RateLimiter between downloaders, nil disables rate limiting
+func (d *KlineDownloader) WithRateLimiter(limiter *RateLimiter) *KlineDownloader {
+	d.limiter = limiter
+
+	return d
+}
+
+// WithPageSize : bars per request, DefaultKlinePageSize by default
+func (d *KlineDownloader) WithPageSize(n int) *KlineDownloader {
+	if n > 0 {
+		d.pageSize = n
+	}
+
+	return d
+}
+
+// WithBackward : hands over batches from the newest to the oldest
+// Bars within a batch stay in chronological order.
+func (d *KlineDownloader) WithBackward(backward bool) *KlineDownloader {
+	d.backward = backward
+
+	return d
+}
+
+type klineChunk struct {
+	from  time.Time // first bar start
+	to    time.Time // last bar start
+	count int
+}
+
+type klineChunkResult struct {
+	klines []Kline
+	err    error
+}
+
+// Download : fetches every bar of req and calls handle with each batch in order
+// Bars Bybit does not return are reported as gaps, bars starting after now are not expected.
+// Download stops at the first error of a request or of handle.
+func (d *KlineDownloader) Download(ctx context.Context, req KlineRequest, handle func(KlineBatch) error) error {
+	next, err := klineStep(req.Interval)
+	if err != nil {
+		return err
+	}
+	if req.Done() {
+		return nil
+	}
+	chunks := d.chunks(req, next)
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the semaphore is released once a result is handed over, which bounds what is held in memory
+	sem := make(chan struct{}, d.concurrency)
+	results := make([]chan klineChunkResult, len(chunks))
+	for i := range results {
+		results[i] = make(chan klineChunkResult, 1)
+	}
+	go func() {
+		for i, chunk := range chunks {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func() {
+				klines, err := d.fetch(ctx, req, chunk)
+				results[i] <- klineChunkResult{klines: klines, err: err}
+			}()
+		}
+	}()
+
+	for i, chunk := range chunks {
+		var result klineChunkResult
+		select {
+		case result = <-results[i]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		<-sem
+		if result.err != nil {
+			return fmt.Errorf("klines from %v: %w", chunk.from, result.err)
+		}
+
+		checkpoint := req
+		if d.backward {
+			checkpoint.End = chunk.from.Add(-time.Millisecond)
+		} else {
+			checkpoint.Start = next(chunk.to)
+		}
+		if i == len(chunks)-1 {
+			checkpoint.End = checkpoint.Start.Add(-time.Millisecond)
+		}
+		batch := KlineBatch{
+			Klines:     result.klines,
+			Gaps:       klineGaps(result.klines, chunk, next, d.now()),
+			Checkpoint: checkpoint,
+		}
+		if err := handle(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DownloadAll : every bar of req in chronological order, with the gaps
+func (d *KlineDownloader) DownloadAll(ctx context.Context, req KlineRequest) ([]Kline, []KlineGap, error) {
+	var (
+		klines []Kline
+		gaps   []KlineGap
+	)
+	err := d.Download(ctx, req, func(batch KlineBatch) error {
+		klines = append(klines, batch.Klines...)
+		gaps = append(gaps, batch.Gaps...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(klines, func(i, j int) bool { return klines[i].Start.Before(klines[j].Start) })
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i].From.Before(gaps[j].From) })
+	return klines, gaps, nil
+}
+
+// chunks splits the bar starts of req into requests of up to the page size, in hand over order
+func (d *KlineDownloader) chunks(req KlineRequest, next func(time.Time) time.Time) []klineChunk {
+	var chunks []klineChunk
+	var current *klineChunk
+	for at := klineAlign(req.Interval, req.Start, next); !at.After(req.End); at = next(at) {
+		if current == nil || current.count == d.pageSize {
+			chunks = append(chunks, klineChunk{from: at})
+			current = &chunks[len(chunks)-1]
+		}
+		current.to = at
+		current.count++
+	}
+	if d.backward {
+		for i, j := 0, len(chunks)-1; i < j; i, j = i+1, j-1 {
+			chunks[i], chunks[j] = chunks[j], chunks[i]
+		}
+	}
+	return chunks
+}
+
+// fetch requests the bars of the chunk, oldest first
+func (d *KlineDownloader) fetch(ctx context.Context, req KlineRequest, chunk klineChunk) ([]Kline, error) {
+	if d.limiter != nil {
+		if err := d.limiter.wait(ctx, klineRateLimitGroup); err != nil {
+			return nil, err
+		}
+	}
+
+	start, end, limit := chunk.from.UnixMilli(), chunk.to.UnixMilli(), chunk.count
+	var klines []Kline
+	switch req.Series {
+	case KlineSeriesTrade, "":
+		res, err := d.market.GetKlineWithContext(ctx, V5GetKlineParam{
+			Category: req.Category, Symbol: req.Symbol, Interval: req.Interval, Start: &start, End: &end, Limit: &limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.Result.List {
+			klines = append(klines, Kline{Open: item.Open, High: item.High, Low: item.Low, Close: item.Close, Volume: item.Volume, Turnover: item.Turnover, Start: parseKlineStart(item.StartTime)})
+		}
+	case KlineSeriesMarkPrice:
+		res, err := d.market.GetMarkPriceKlineWithContext(ctx, V5GetMarkPriceKlineParam{
+			Category: req.Category, Symbol: req.Symbol, Interval: req.Interval, Start: &start, End: &end, Limit: &limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.Result.List {
+			klines = append(klines, Kline{Open: item.Open, High: item.High, Low: item.Low, Close: item.Close, Start: parseKlineStart(item.StartTime)})
+		}
+	case KlineSeriesIndexPrice:
+		res, err := d.market.GetIndexPriceKlineWithContext(ctx, V5GetIndexPriceKlineParam{
+			Category: req.Category, Symbol: req.Symbol, Interval: req.Interval, Start: &start, End: &end, Limit: &limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.Result.List {
+			klines = append(klines, Kline{Open: item.Open, High: item.High, Low: item.Low, Close: item.Close, Start: parseKlineStart(item.StartTime)})
+		}
+	case KlineSeriesPremiumIndexPrice:
+		res, err := d.market.GetPremiumIndexPriceKlineWithContext(ctx, V5GetPremiumIndexPriceKlineParam{
+			Category: req.Category, Symbol: req.Symbol, Interval: req.Interval, Start: &start, End: &end, Limit: &limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.Result.List {
+			klines = append(klines, Kline{Open: item.Open, High: item.High, Low: item.Low, Close: item.Close, Start: parseKlineStart(item.StartTime)})
+		}
+	default:
+		return nil, fmt.Errorf("unknown kline series %q", req.Series)
+	}
+
+	// Bybit returns the newest bar first, keep the ones of the chunk oldest first without duplicates
+	sort.Slice(klines, func(i, j int) bool { return klines[i].Start.Before(klines[j].Start) })
+	filtered := klines[:0]
+	for _, k := range klines {
+		if k.Start.Before(chunk.from) || k.Start.After(chunk.to) {
+			continue
+		}
+		if n := len(filtered); n > 0 && filtered[n-1].Start.Equal(k.Start) {
+			continue
+		}
+		filtered = append(filtered, k)
+	}
+	return filtered, nil
+}
+
+func parseKlineStart(ms string) time.Time {
+	v, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(v).UTC()
+}
+
+// klineGaps compares the bars against every start the chunk should have up to now
+func klineGaps(klines []Kline, chunk klineChunk, next func(time.Time) time.Time, now time.Time) []KlineGap {
+	var (
+		gaps []KlineGap
+		gap  *KlineGap
+		i    int
+	)
+	for at := chunk.from; !at.After(chunk.to) && !at.After(now); at = next(at) {
+		for i < len(klines) && klines[i].Start.Before(at) {
+			i++
+		}
+		if i < len(klines) && klines[i].Start.Equal(at) {
+			gap = nil
+			continue
+		}
+		if gap == nil {
+			gaps = append(gaps, KlineGap{From: at})
+			gap = &gaps[len(gaps)-1]
+		}
+		gap.To = at
+		gap.Missing++
+	}
+	return gaps
+}
+
+// klineStep returns the function giving the start of the bar after the one starting at t
+func klineStep(interval Interval) (func(time.Time) time.Time, error) {
+	switch interval {
+	case IntervalD:
+		return func(t time.Time) time.Time { return t.Add(24 * time.Hour) }, nil
+	case IntervalW:
+		return func(t time.Time) time.Time { return t.Add(7 * 24 * time.Hour) }, nil
+	case IntervalM:
+		return func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }, nil
+	}
+	minutes, err := strconv.Atoi(string(interval))
+	if err != nil || minutes <= 0 {
+		return nil, fmt.Errorf("unknown interval %q", interval)
+	}
+	d := time.Duration(minutes) * time.Minute
+	return func(t time.Time) time.Time { return t.Add(d) }, nil
+}
+
+// klineAlign returns the first bar start at or after t
+// Bars start on multiples of the interval since the epoch in UTC, weeks on Monday and months on the 1st.
+func klineAlign(interval Interval, t time.Time, next func(time.Time) time.Time) time.Time {
+	t = t.UTC()
+	var aligned time.Time
+	switch interval {
+	case IntervalM:
+		aligned = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case IntervalW:
+		// the epoch is a Thursday, the first Monday is four days later
+		monday := time.Unix(0, 0).UTC().AddDate(0, 0, 4)
+		week := 7 * 24 * time.Hour
+		aligned = monday.Add(t.Sub(monday) / week * week)
+		if aligned.After(t) {
+			aligned = aligned.Add(-week)
+		}
+	default:
+		d := next(time.Unix(0, 0).UTC()).Sub(time.Unix(0, 0).UTC())
+		aligned = time.UnixMilli(t.UnixMilli() / d.Milliseconds() * d.Milliseconds()).UTC()
+	}
+	if aligned.Before(t) {
+		aligned = next(aligned)
+	}
+	return aligned
+}
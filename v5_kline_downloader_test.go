@@ -0,0 +1,205 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withKlineHandlerOption serves one minute bars between start and end, newest first, leaving out missing
+func withKlineHandlerOption(path string, calls *int32, missing map[int64]bool) func(*http.ServeMux) {
+	return func(mux *http.ServeMux) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(calls, 1)
+			query := r.URL.Query()
+			start, _ := strconv.ParseInt(query.Get("start"), 10, 64)
+			end, _ := strconv.ParseInt(query.Get("end"), 10, 64)
+
+			list := [][]string{}
+			for at := end; at >= start; at -= time.Minute.Milliseconds() {
+				if missing[at] {
+					continue
+				}
+				price := strconv.FormatInt(at/time.Minute.Milliseconds(), 10)
+				bar := []string{strconv.FormatInt(at, 10), price, price, price, price}
+				if path == "/v5/market/kline" {
+					bar = append(bar, "1", "2")
+				}
+				list = append(list, bar)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"retCode": 0,
+				"result": map[string]interface{}{
+					"category": "linear",
+					"symbol":   "BTCUSDT",
+					"list":     list,
+				},
+			})
+		})
+	}
+}
+
+func TestKlineDownloader(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := KlineRequest{
+		Series:   KlineSeriesTrade,
+		Category: CategoryV5Linear,
+		Symbol:   SymbolV5BTCUSDT,
+		Interval: Interval1,
+		Start:    start.Add(30 * time.Second), // not aligned, the first bar is 00:01
+		End:      start.Add(25 * time.Minute),
+	}
+	missing := map[int64]bool{
+		start.Add(5 * time.Minute).UnixMilli():  true,
+		start.Add(6 * time.Minute).UnixMilli():  true,
+		start.Add(20 * time.Minute).UnixMilli(): true,
+	}
+
+	t.Run("chronological bars and gaps", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(withKlineHandlerOption("/v5/market/kline", &calls, missing))
+		defer teardown()
+		client := NewTestClient().WithBaseURL(server.URL)
+
+		klines, gaps, err := NewKlineDownloader(client.V5().Market()).
+			WithRateLimiter(nil).
+			WithPageSize(4).
+			WithConcurrency(3).
+			DownloadAll(context.Background(), req)
+		require.NoError(t, err)
+		require.Len(t, klines, 22)
+		assert.Equal(t, start.Add(time.Minute), klines[0].Start)
+		assert.Equal(t, start.Add(25*time.Minute), klines[len(klines)-1].Start)
+		for i := 1; i < len(klines); i++ {
+			assert.True(t, klines[i-1].Start.Before(klines[i].Start))
+		}
+		assert.Equal(t, "2", klines[0].Turnover)
+		assert.Equal(t, []KlineGap{
+			{From: start.Add(5 * time.Minute), To: start.Add(6 * time.Minute), Missing: 2},
+			{From: start.Add(20 * time.Minute), To: start.Add(20 * time.Minute), Missing: 1},
+		}, gaps)
+		assert.Equal(t, int32(7), calls)
+	})
+	t.Run("resume from checkpoint", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(withKlineHandlerOption("/v5/market/mark-price-kline", &calls, nil))
+		defer teardown()
+		downloader := NewKlineDownloader(NewTestClient().WithBaseURL(server.URL).V5().Market()).
+			WithRateLimiter(nil).
+			WithPageSize(10)
+
+		markReq := req
+		markReq.Series = KlineSeriesMarkPrice
+		stop := errors.New("stop")
+		var checkpoint KlineRequest
+		err := downloader.Download(context.Background(), markReq, func(batch KlineBatch) error {
+			checkpoint = batch.Checkpoint
+			return stop
+		})
+		require.ErrorIs(t, err, stop)
+		assert.Equal(t, start.Add(11*time.Minute), checkpoint.Start)
+		assert.False(t, checkpoint.Done())
+
+		var rest []Kline
+		err = downloader.Download(context.Background(), checkpoint, func(batch KlineBatch) error {
+			rest = append(rest, batch.Klines...)
+			checkpoint = batch.Checkpoint
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, rest, 15)
+		assert.Equal(t, start.Add(11*time.Minute), rest[0].Start)
+		assert.Empty(t, rest[0].Volume)
+		assert.True(t, checkpoint.Done())
+	})
+	t.Run("backward", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(withKlineHandlerOption("/v5/market/index-price-kline", &calls, nil))
+		defer teardown()
+		downloader := NewKlineDownloader(NewTestClient().WithBaseURL(server.URL).V5().Market()).
+			WithRateLimiter(nil).
+			WithPageSize(10).
+			WithBackward(true)
+
+		indexReq := req
+		indexReq.Series = KlineSeriesIndexPrice
+		var firsts []time.Time
+		var last KlineRequest
+		err := downloader.Download(context.Background(), indexReq, func(batch KlineBatch) error {
+			firsts = append(firsts, batch.Klines[0].Start)
+			for i := 1; i < len(batch.Klines); i++ {
+				assert.True(t, batch.Klines[i-1].Start.Before(batch.Klines[i].Start))
+			}
+			last = batch.Checkpoint
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{start.Add(21 * time.Minute), start.Add(11 * time.Minute), start.Add(time.Minute)}, firsts)
+		assert.True(t, last.Done())
+	})
+	t.Run("rate limited", func(t *testing.T) {
+		var calls int32
+		server, teardown := testhelper.NewServer(withKlineHandlerOption("/v5/market/kline", &calls, nil))
+		defer teardown()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		limiter := NewRateLimiter()
+		assert.Equal(t, 120, limiter.Budget(klineRateLimitGroup).Limit)
+		header := http.Header{}
+		header.Set("X-Bapi-Limit", "5")
+		header.Set("X-Bapi-Limit-Status", "5")
+		limiter.update(klineRateLimitGroup, header)
+
+		_, _, err := NewKlineDownloader(NewTestClient().WithBaseURL(server.URL).V5().Market()).
+			WithRateLimiter(limiter).
+			WithPageSize(1).
+			WithConcurrency(10).
+			DownloadAll(ctx, req)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(5), calls)
+	})
+	t.Run("client rate limiter", func(t *testing.T) {
+		limiter := NewRateLimiter()
+		client := NewTestClient().WithRateLimiter(limiter)
+		assert.Same(t, limiter, NewKlineDownloader(client.V5().Market()).limiter)
+
+		other := NewKlineDownloader(NewTestClient().V5().Market()).limiter
+		assert.NotNil(t, other)
+		assert.NotSame(t, limiter, other)
+	})
+	t.Run("unknown interval", func(t *testing.T) {
+		bad := req
+		bad.Interval = Interval("2h")
+		_, _, err := NewKlineDownloader(NewTestClient().V5().Market()).DownloadAll(context.Background(), bad)
+		assert.Error(t, err)
+	})
+}
+
+func TestKlineAlign(t *testing.T) {
+	at := time.Date(2024, 1, 3, 10, 17, 0, 0, time.UTC) // a Wednesday
+	for _, tc := range []struct {
+		interval Interval
+		want     time.Time
+	}{
+		{Interval15, time.Date(2024, 1, 3, 10, 30, 0, 0, time.UTC)},
+		{Interval240, time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)},
+		{IntervalD, time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{IntervalW, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{IntervalM, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		next, err := klineStep(tc.interval)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, klineAlign(tc.interval, at, next), tc.interval)
+	}
+}
@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,18 +12,33 @@ import (
 // V5MarketServiceI :
 type V5MarketServiceI interface {
 	GetKline(V5GetKlineParam) (*V5GetKlineResponse, error)
+	GetKlineWithContext(context.Context, V5GetKlineParam) (*V5GetKlineResponse, error)
 	GetMarkPriceKline(V5GetMarkPriceKlineParam) (*V5GetMarkPriceKlineResponse, error)
+	GetMarkPriceKlineWithContext(context.Context, V5GetMarkPriceKlineParam) (*V5GetMarkPriceKlineResponse, error)
 	GetIndexPriceKline(V5GetIndexPriceKlineParam) (*V5GetIndexPriceKlineResponse, error)
+	GetIndexPriceKlineWithContext(context.Context, V5GetIndexPriceKlineParam) (*V5GetIndexPriceKlineResponse, error)
 	GetPremiumIndexPriceKline(V5GetPremiumIndexPriceKlineParam) (*V5GetPremiumIndexPriceKlineResponse, error)
+	GetPremiumIndexPriceKlineWithContext(context.Context, V5GetPremiumIndexPriceKlineParam) (*V5GetPremiumIndexPriceKlineResponse, error)
 	GetInstrumentsInfo(V5GetInstrumentsInfoParam) (*V5GetInstrumentsInfoResponse, error)
+	GetInstrumentsInfoWithContext(context.Context, V5GetInstrumentsInfoParam) (*V5GetInstrumentsInfoResponse, error)
 	GetOrderbook(V5GetOrderbookParam) (*V5GetOrderbookResponse, error)
+	GetOrderbookWithContext(context.Context, V5GetOrderbookParam) (*V5GetOrderbookResponse, error)
 	GetTickers(V5GetTickersParam) (*V5GetTickersResponse, error)
+	GetTickersWithContext(context.Context, V5GetTickersParam) (*V5GetTickersResponse, error)
 	GetFundingRateHistory(V5GetFundingRateHistoryParam) (*V5GetFundingRateHistoryResponse, error)
+	GetFundingRateHistoryWithContext(context.Context, V5GetFundingRateHistoryParam) (*V5GetFundingRateHistoryResponse, error)
 	GetPublicTradingHistory(V5GetPublicTradingHistoryParam) (*V5GetPublicTradingHistoryResponse, error)
+	GetPublicTradingHistoryWithContext(context.Context, V5GetPublicTradingHistoryParam) (*V5GetPublicTradingHistoryResponse, error)
 	GetOpenInterest(V5GetOpenInterestParam) (*V5GetOpenInterestResponse, error)
+	GetOpenInterestWithContext(context.Context, V5GetOpenInterestParam) (*V5GetOpenInterestResponse, error)
 	GetHistoricalVolatility(V5GetHistoricalVolatilityParam) (*V5GetHistoricalVolatilityResponse, error)
+	GetHistoricalVolatilityWithContext(context.Context, V5GetHistoricalVolatilityParam) (*V5GetHistoricalVolatilityResponse, error)
 	GetInsurance(V5GetInsuranceParam) (*V5GetInsuranceResponse, error)
+	GetInsuranceWithContext(context.Context, V5GetInsuranceParam) (*V5GetInsuranceResponse, error)
 	GetRiskLimit(V5GetRiskLimitParam) (*V5GetRiskLimitResponse, error)
+	GetRiskLimitWithContext(context.Context, V5GetRiskLimitParam) (*V5GetRiskLimitResponse, error)
+	GetServerTime() (*V5GetServerTimeResponse, error)
+	GetServerTimeWithContext(context.Context) (*V5GetServerTimeResponse, error)
 }
 
 // V5MarketService :
@@ -93,6 +109,11 @@ func (l *V5GetKlineList) UnmarshalJSON(data []byte) error {
 
 // GetKline :
 func (s *V5MarketService) GetKline(param V5GetKlineParam) (*V5GetKlineResponse, error) {
+	return s.GetKlineWithContext(context.Background(), param)
+}
+
+// GetKlineWithContext :
+func (s *V5MarketService) GetKlineWithContext(ctx context.Context, param V5GetKlineParam) (*V5GetKlineResponse, error) {
 	var res V5GetKlineResponse
 
 	queryString, err := query.Values(param)
@@ -100,7 +121,7 @@ func (s *V5MarketService) GetKline(param V5GetKlineParam) (*V5GetKlineResponse,
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/kline", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/kline", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -165,6 +186,11 @@ func (l *V5GetMarkPriceKlineList) UnmarshalJSON(data []byte) error {
 
 // GetMarkPriceKline :
 func (s *V5MarketService) GetMarkPriceKline(param V5GetMarkPriceKlineParam) (*V5GetMarkPriceKlineResponse, error) {
+	return s.GetMarkPriceKlineWithContext(context.Background(), param)
+}
+
+// GetMarkPriceKlineWithContext :
+func (s *V5MarketService) GetMarkPriceKlineWithContext(ctx context.Context, param V5GetMarkPriceKlineParam) (*V5GetMarkPriceKlineResponse, error) {
 	var res V5GetMarkPriceKlineResponse
 
 	if param.Category != CategoryV5Linear && param.Category != CategoryV5Inverse {
@@ -176,7 +202,7 @@ func (s *V5MarketService) GetMarkPriceKline(param V5GetMarkPriceKlineParam) (*V5
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/mark-price-kline", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/mark-price-kline", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -241,6 +267,11 @@ func (l *V5GetIndexPriceKlineList) UnmarshalJSON(data []byte) error {
 
 // GetIndexPriceKline :
 func (s *V5MarketService) GetIndexPriceKline(param V5GetIndexPriceKlineParam) (*V5GetIndexPriceKlineResponse, error) {
+	return s.GetIndexPriceKlineWithContext(context.Background(), param)
+}
+
+// GetIndexPriceKlineWithContext :
+func (s *V5MarketService) GetIndexPriceKlineWithContext(ctx context.Context, param V5GetIndexPriceKlineParam) (*V5GetIndexPriceKlineResponse, error) {
 	var res V5GetIndexPriceKlineResponse
 
 	if param.Category != CategoryV5Linear && param.Category != CategoryV5Inverse {
@@ -252,7 +283,7 @@ func (s *V5MarketService) GetIndexPriceKline(param V5GetIndexPriceKlineParam) (*
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/index-price-kline", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/index-price-kline", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -304,7 +335,6 @@ func (l *V5GetPremiumIndexPriceKlineList) UnmarshalJSON(data []byte) error {
 		if len(d) != 5 {
 			return errors.New("so far len(items) must be 5, please check it on documents")
 		}
-		logger.Printf("parse v5 get premium index price kline list item: %v", d)
 		*l = append(*l, V5GetPremiumIndexPriceKlineItem{
 			StartTime: d[0].(string),
 			Open:      d[1].(string),
@@ -318,6 +348,11 @@ func (l *V5GetPremiumIndexPriceKlineList) UnmarshalJSON(data []byte) error {
 
 // GetPremiumIndexPriceKline :
 func (s *V5MarketService) GetPremiumIndexPriceKline(param V5GetPremiumIndexPriceKlineParam) (*V5GetPremiumIndexPriceKlineResponse, error) {
+	return s.GetPremiumIndexPriceKlineWithContext(context.Background(), param)
+}
+
+// GetPremiumIndexPriceKlineWithContext :
+func (s *V5MarketService) GetPremiumIndexPriceKlineWithContext(ctx context.Context, param V5GetPremiumIndexPriceKlineParam) (*V5GetPremiumIndexPriceKlineResponse, error) {
 	var res V5GetPremiumIndexPriceKlineResponse
 
 	if param.Category != CategoryV5Linear {
@@ -329,7 +364,7 @@ func (s *V5MarketService) GetPremiumIndexPriceKline(param V5GetPremiumIndexPrice
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/premium-index-price-kline", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/premium-index-price-kline", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -501,6 +536,11 @@ type SpotPriceFilterV5 struct {
 
 // GetInstrumentsInfo :
 func (s *V5MarketService) GetInstrumentsInfo(param V5GetInstrumentsInfoParam) (*V5GetInstrumentsInfoResponse, error) {
+	return s.GetInstrumentsInfoWithContext(context.Background(), param)
+}
+
+// GetInstrumentsInfoWithContext :
+func (s *V5MarketService) GetInstrumentsInfoWithContext(ctx context.Context, param V5GetInstrumentsInfoParam) (*V5GetInstrumentsInfoResponse, error) {
 	var res V5GetInstrumentsInfoResponse
 
 	queryString, err := query.Values(param)
@@ -508,7 +548,7 @@ func (s *V5MarketService) GetInstrumentsInfo(param V5GetInstrumentsInfoParam) (*
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/instruments-info", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/instruments-info", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -573,6 +613,11 @@ type V5GetOrderbookBidAsk struct {
 
 // GetOrderbook :
 func (s *V5MarketService) GetOrderbook(param V5GetOrderbookParam) (*V5GetOrderbookResponse, error) {
+	return s.GetOrderbookWithContext(context.Background(), param)
+}
+
+// GetOrderbookWithContext :
+func (s *V5MarketService) GetOrderbookWithContext(ctx context.Context, param V5GetOrderbookParam) (*V5GetOrderbookResponse, error) {
 	var res V5GetOrderbookResponse
 
 	queryString, err := query.Values(param)
@@ -580,7 +625,7 @@ func (s *V5MarketService) GetOrderbook(param V5GetOrderbookParam) (*V5GetOrderbo
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/orderbook", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/orderbook", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -740,6 +785,11 @@ type V5GetTickersSpotItem struct {
 
 // GetTickers :
 func (s *V5MarketService) GetTickers(param V5GetTickersParam) (*V5GetTickersResponse, error) {
+	return s.GetTickersWithContext(context.Background(), param)
+}
+
+// GetTickersWithContext :
+func (s *V5MarketService) GetTickersWithContext(ctx context.Context, param V5GetTickersParam) (*V5GetTickersResponse, error) {
 	var res V5GetTickersResponse
 
 	if err := param.validate(); err != nil {
@@ -751,7 +801,7 @@ func (s *V5MarketService) GetTickers(param V5GetTickersParam) (*V5GetTickersResp
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/tickers", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/tickers", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -795,6 +845,11 @@ type V5GetFundingRateHistoryItem struct {
 
 // GetFundingRateHistory :
 func (s *V5MarketService) GetFundingRateHistory(param V5GetFundingRateHistoryParam) (*V5GetFundingRateHistoryResponse, error) {
+	return s.GetFundingRateHistoryWithContext(context.Background(), param)
+}
+
+// GetFundingRateHistoryWithContext :
+func (s *V5MarketService) GetFundingRateHistoryWithContext(ctx context.Context, param V5GetFundingRateHistoryParam) (*V5GetFundingRateHistoryResponse, error) {
 	var res V5GetFundingRateHistoryResponse
 
 	if err := param.validate(); err != nil {
@@ -806,7 +861,7 @@ func (s *V5MarketService) GetFundingRateHistory(param V5GetFundingRateHistoryPar
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/funding/history", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/funding/history", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -858,6 +913,11 @@ type V5GetPublicTradingHistoryItem struct {
 
 // GetPublicTradingHistory :
 func (s *V5MarketService) GetPublicTradingHistory(param V5GetPublicTradingHistoryParam) (*V5GetPublicTradingHistoryResponse, error) {
+	return s.GetPublicTradingHistoryWithContext(context.Background(), param)
+}
+
+// GetPublicTradingHistoryWithContext :
+func (s *V5MarketService) GetPublicTradingHistoryWithContext(ctx context.Context, param V5GetPublicTradingHistoryParam) (*V5GetPublicTradingHistoryResponse, error) {
 	var res V5GetPublicTradingHistoryResponse
 
 	if err := param.validate(); err != nil {
@@ -869,7 +929,7 @@ func (s *V5MarketService) GetPublicTradingHistory(param V5GetPublicTradingHistor
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/recent-trade", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/recent-trade", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -916,6 +976,11 @@ type V5GetOpenInterestItem struct {
 
 // GetOpenInterest :
 func (s *V5MarketService) GetOpenInterest(param V5GetOpenInterestParam) (*V5GetOpenInterestResponse, error) {
+	return s.GetOpenInterestWithContext(context.Background(), param)
+}
+
+// GetOpenInterestWithContext :
+func (s *V5MarketService) GetOpenInterestWithContext(ctx context.Context, param V5GetOpenInterestParam) (*V5GetOpenInterestResponse, error) {
 	var res V5GetOpenInterestResponse
 
 	if err := param.validate(); err != nil {
@@ -927,7 +992,7 @@ func (s *V5MarketService) GetOpenInterest(param V5GetOpenInterestParam) (*V5GetO
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/open-interest", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/open-interest", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -993,6 +1058,11 @@ type V5GetHistoricalVolatilityListItem struct {
 
 // GetHistoricalVolatility :
 func (s *V5MarketService) GetHistoricalVolatility(param V5GetHistoricalVolatilityParam) (*V5GetHistoricalVolatilityResponse, error) {
+	return s.GetHistoricalVolatilityWithContext(context.Background(), param)
+}
+
+// GetHistoricalVolatilityWithContext :
+func (s *V5MarketService) GetHistoricalVolatilityWithContext(ctx context.Context, param V5GetHistoricalVolatilityParam) (*V5GetHistoricalVolatilityResponse, error) {
 	var res V5GetHistoricalVolatilityResponse
 
 	if err := param.validate(); err != nil {
@@ -1004,7 +1074,7 @@ func (s *V5MarketService) GetHistoricalVolatility(param V5GetHistoricalVolatilit
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/historical-volatility", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/historical-volatility", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -1036,6 +1106,11 @@ type V5GetInsuranceItem struct {
 
 // GetInsurance :
 func (s *V5MarketService) GetInsurance(param V5GetInsuranceParam) (*V5GetInsuranceResponse, error) {
+	return s.GetInsuranceWithContext(context.Background(), param)
+}
+
+// GetInsuranceWithContext :
+func (s *V5MarketService) GetInsuranceWithContext(ctx context.Context, param V5GetInsuranceParam) (*V5GetInsuranceResponse, error) {
 	var res V5GetInsuranceResponse
 
 	queryString, err := query.Values(param)
@@ -1043,7 +1118,7 @@ func (s *V5MarketService) GetInsurance(param V5GetInsuranceParam) (*V5GetInsuran
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/insurance", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/insurance", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -1090,6 +1165,11 @@ type V5GetRiskLimitItem struct {
 
 // GetRiskLimit :
 func (s *V5MarketService) GetRiskLimit(param V5GetRiskLimitParam) (*V5GetRiskLimitResponse, error) {
+	return s.GetRiskLimitWithContext(context.Background(), param)
+}
+
+// GetRiskLimitWithContext :
+func (s *V5MarketService) GetRiskLimitWithContext(ctx context.Context, param V5GetRiskLimitParam) (*V5GetRiskLimitResponse, error) {
 	var res V5GetRiskLimitResponse
 
 	if err := param.validate(); err != nil {
@@ -1101,7 +1181,35 @@ func (s *V5MarketService) GetRiskLimit(param V5GetRiskLimitParam) (*V5GetRiskLim
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/risk-limit", queryString, &res); err != nil {
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/risk-limit", param, queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetServerTimeResponse :
+type V5GetServerTimeResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetServerTimeResult `json:"result"`
+}
+
+// V5GetServerTimeResult :
+type V5GetServerTimeResult struct {
+	TimeSecond string `json:"timeSecond"`
+	TimeNano   string `json:"timeNano"`
+}
+
+// GetServerTime :
+func (s *V5MarketService) GetServerTime() (*V5GetServerTimeResponse, error) {
+	return s.GetServerTimeWithContext(context.Background())
+}
+
+// GetServerTimeWithContext :
+func (s *V5MarketService) GetServerTimeWithContext(ctx context.Context) (*V5GetServerTimeResponse, error) {
+	var res V5GetServerTimeResponse
+
+	if err := s.client.getPubliclyWithContext(ctx, "/v5/market/time", nil, nil, &res); err != nil {
 		return nil, err
 	}
 
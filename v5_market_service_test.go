@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -56,6 +57,50 @@ func TestV5MarketGetKline(t *testing.T) {
 	assert.Equal(t, respBody["result"].(map[string]interface{})["list"].([][]string)[0][0], resp.Result.List[0].StartTime)
 }
 
+func TestV5MarketGetKlineWithContext(t *testing.T) {
+	param := V5GetKlineParam{
+		Category: CategoryV5Spot,
+		Symbol:   SymbolV5BTCUSDT,
+		Interval: IntervalD,
+	}
+
+	path := "/v5/market/kline"
+	method := http.MethodGet
+	status := http.StatusOK
+	respBody := map[string]interface{}{
+		"result": map[string]interface{}{
+			"category": "linear",
+			"symbol":   "BTCUSDT",
+			"list":     [][]string{},
+		},
+	}
+	bytesBody, err := json.Marshal(respBody)
+	require.NoError(t, err)
+
+	server, teardown := testhelper.NewServer(
+		testhelper.WithHandlerOption(path, method, status, bytesBody),
+	)
+	defer teardown()
+
+	client := NewTestClient().
+		WithBaseURL(server.URL)
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := client.V5().Market().GetKlineWithContext(context.Background(), param)
+		require.NoError(t, err)
+
+		require.NotNil(t, resp)
+		assert.Equal(t, respBody["result"].(map[string]interface{})["symbol"], string(resp.Result.Symbol))
+	})
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		_, err := client.V5().Market().GetKlineWithContext(ctx, param)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
 func TestV5Market_GetMarkPriceKline(t *testing.T) {
 	param := V5GetMarkPriceKlineParam{
 		Category: CategoryV5Linear,
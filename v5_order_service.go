@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -10,11 +11,17 @@ import (
 // V5OrderServiceI :
 type V5OrderServiceI interface {
 	CreateOrder(V5CreateOrderParam) (*V5CreateOrderResponse, error)
+	CreateOrderWithContext(context.Context, V5CreateOrderParam) (*V5CreateOrderResponse, error)
 	AmendOrder(V5AmendOrderParam) (*V5AmendOrderResponse, error)
+	AmendOrderWithContext(context.Context, V5AmendOrderParam) (*V5AmendOrderResponse, error)
 	CancelOrder(V5CancelOrderParam) (*V5CancelOrderResponse, error)
+	CancelOrderWithContext(context.Context, V5CancelOrderParam) (*V5CancelOrderResponse, error)
 	GetOpenOrders(V5GetOpenOrdersParam) (*V5GetOrdersResponse, error)
+	GetOpenOrdersWithContext(context.Context, V5GetOpenOrdersParam) (*V5GetOrdersResponse, error)
 	CancelAllOrders(V5CancelAllOrdersParam) (*V5CancelAllOrdersResponse, error)
+	CancelAllOrdersWithContext(context.Context, V5CancelAllOrdersParam) (*V5CancelAllOrdersResponse, error)
 	GetHistoryOrders(V5GetHistoryOrdersParam) (*V5GetOrdersResponse, error)
+	GetHistoryOrdersWithContext(context.Context, V5GetHistoryOrdersParam) (*V5GetOrdersResponse, error)
 }
 
 // V5OrderService :
@@ -72,6 +79,11 @@ type V5CreateOrderResult struct {
 
 // CreateOrder :
 func (s *V5OrderService) CreateOrder(param V5CreateOrderParam) (*V5CreateOrderResponse, error) {
+	return s.CreateOrderWithContext(context.Background(), param)
+}
+
+// CreateOrderWithContext :
+func (s *V5OrderService) CreateOrderWithContext(ctx context.Context, param V5CreateOrderParam) (*V5CreateOrderResponse, error) {
 	var res V5CreateOrderResponse
 
 	body, err := json.Marshal(param)
@@ -79,7 +91,7 @@ func (s *V5OrderService) CreateOrder(param V5CreateOrderParam) (*V5CreateOrderRe
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/order/create", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/order/create", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -128,6 +140,11 @@ type V5AmendOrderResult struct {
 
 // AmendOrder :
 func (s *V5OrderService) AmendOrder(param V5AmendOrderParam) (*V5AmendOrderResponse, error) {
+	return s.AmendOrderWithContext(context.Background(), param)
+}
+
+// AmendOrderWithContext :
+func (s *V5OrderService) AmendOrderWithContext(ctx context.Context, param V5AmendOrderParam) (*V5AmendOrderResponse, error) {
 	var res V5AmendOrderResponse
 
 	if err := param.validate(); err != nil {
@@ -139,7 +156,7 @@ func (s *V5OrderService) AmendOrder(param V5AmendOrderParam) (*V5AmendOrderRespo
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/order/amend", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/order/amend", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -170,6 +187,11 @@ type V5CancelOrderResult struct {
 
 // CancelOrder :
 func (s *V5OrderService) CancelOrder(param V5CancelOrderParam) (*V5CancelOrderResponse, error) {
+	return s.CancelOrderWithContext(context.Background(), param)
+}
+
+// CancelOrderWithContext :
+func (s *V5OrderService) CancelOrderWithContext(ctx context.Context, param V5CancelOrderParam) (*V5CancelOrderResponse, error) {
 	var res V5CancelOrderResponse
 
 	if param.OrderID == nil && param.OrderLinkID == nil {
@@ -181,7 +203,7 @@ func (s *V5OrderService) CancelOrder(param V5CancelOrderParam) (*V5CancelOrderRe
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/order/cancel", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/order/cancel", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -272,6 +294,11 @@ type V5GetOrder struct {
 
 // GetOpenOrders :
 func (s *V5OrderService) GetOpenOrders(param V5GetOpenOrdersParam) (*V5GetOrdersResponse, error) {
+	return s.GetOpenOrdersWithContext(context.Background(), param)
+}
+
+// GetOpenOrdersWithContext :
+func (s *V5OrderService) GetOpenOrdersWithContext(ctx context.Context, param V5GetOpenOrdersParam) (*V5GetOrdersResponse, error) {
 	var res V5GetOrdersResponse
 
 	if param.Category == "" {
@@ -283,7 +310,7 @@ func (s *V5OrderService) GetOpenOrders(param V5GetOpenOrdersParam) (*V5GetOrders
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/order/realtime", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/order/realtime", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -292,6 +319,11 @@ func (s *V5OrderService) GetOpenOrders(param V5GetOpenOrdersParam) (*V5GetOrders
 
 // GetHistoryOrders :
 func (s *V5OrderService) GetHistoryOrders(param V5GetHistoryOrdersParam) (*V5GetOrdersResponse, error) {
+	return s.GetHistoryOrdersWithContext(context.Background(), param)
+}
+
+// GetHistoryOrdersWithContext :
+func (s *V5OrderService) GetHistoryOrdersWithContext(ctx context.Context, param V5GetHistoryOrdersParam) (*V5GetOrdersResponse, error) {
 	var res V5GetOrdersResponse
 
 	if param.Category == "" {
@@ -303,7 +335,7 @@ func (s *V5OrderService) GetHistoryOrders(param V5GetHistoryOrdersParam) (*V5Get
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/order/history", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/order/history", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -371,6 +403,11 @@ type V5CancelAllOrdersSpotResult struct {
 
 // CancelAllOrders :
 func (s *V5OrderService) CancelAllOrders(param V5CancelAllOrdersParam) (*V5CancelAllOrdersResponse, error) {
+	return s.CancelAllOrdersWithContext(context.Background(), param)
+}
+
+// CancelAllOrdersWithContext :
+func (s *V5OrderService) CancelAllOrdersWithContext(ctx context.Context, param V5CancelAllOrdersParam) (*V5CancelAllOrdersResponse, error) {
 	var res V5CancelAllOrdersResponse
 
 	if err := param.validate(); err != nil {
@@ -382,7 +419,7 @@ func (s *V5OrderService) CancelAllOrders(param V5CancelAllOrdersParam) (*V5Cance
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/order/cancel-all", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/order/cancel-all", param, body, &res); err != nil {
 		return &res, err
 	}
 
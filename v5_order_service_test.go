@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -125,6 +126,44 @@ func TestV5Order_CreateOrder(t *testing.T) {
 		_, err = client.V5().Order().CreateOrder(param)
 		assert.Error(t, err)
 	})
+	t.Run("canceled context", func(t *testing.T) {
+		price := "10000.0"
+		param := V5CreateOrderParam{
+			Category:  CategoryV5Spot,
+			Symbol:    SymbolV5BTCUSDT,
+			Side:      SideBuy,
+			OrderType: OrderTypeLimit,
+			Qty:       "0.01",
+			Price:     &price,
+		}
+
+		path := "/v5/order/create"
+		method := http.MethodPost
+		status := http.StatusOK
+		respBody := map[string]interface{}{
+			"result": map[string]interface{}{
+				"orderId":     "1358868270414852352",
+				"orderLinkId": "1676725721103693",
+			},
+		}
+		bytesBody, err := json.Marshal(respBody)
+		require.NoError(t, err)
+
+		server, teardown := testhelper.NewServer(
+			testhelper.WithHandlerOption(path, method, status, bytesBody),
+		)
+		defer teardown()
+
+		client := NewTestClient().
+			WithBaseURL(server.URL).
+			WithAuth("test", "test")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = client.V5().Order().CreateOrderWithContext(ctx, param)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
 }
 
 func TestV5Order_AmendOrder(t *testing.T) {
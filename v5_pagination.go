@@ -0,0 +1,364 @@
+package bybit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// PageOption : configures the V5 list iterators
+// The iterators start from the Cursor of their param when it is set, e.g. to resume from a saved cursor,
+// except the ...InRange ones whose cursors only hold within a window.
+type PageOption func(*pageConfig)
+
+type pageConfig struct {
+	pageSize int
+	maxItems int
+	window   time.Duration
+}
+
+// WithPageSizeOption : the limit sent with each request, the endpoint default when unset
+func WithPageSizeOption(n int) PageOption {
+	return func(c *pageConfig) {
+		c.pageSize = n
+	}
+}
+
+// WithMaxItemsOption : stops the iterator after n items, unlimited when unset
+func WithMaxItemsOption(n int) PageOption {
+	return func(c *pageConfig) {
+		c.maxItems = n
+	}
+}
+
+// pageFetcher requests one page for the cursor, returning its items and the next cursor
+type pageFetcher[T any] func(ctx context.Context, cursor *string, limit *int) ([]T, string, error)
+
+// paginate follows nextPageCursor until it is empty, yielding items one page at a time
+// The first page is requested with start unless it is empty.
+// A failing request is yielded once as the error and ends the iteration.
+func paginate[T any](ctx context.Context, start *string, fetch pageFetcher[T], opts []PageOption) iter.Seq2[T, error] {
+	var config pageConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(yield func(T, error) bool) {
+		var (
+			zero   T
+			cursor *string
+			limit  *int
+			count  int
+			seen   = map[string]bool{}
+		)
+		if config.pageSize > 0 {
+			limit = &config.pageSize
+		}
+		if start != nil && *start != "" {
+			first := *start
+			cursor = &first
+			seen[first] = true
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, next, err := fetch(ctx, cursor, limit)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+				count++
+				if config.maxItems > 0 && count >= config.maxItems {
+					return
+				}
+			}
+			if next == "" || len(items) == 0 {
+				return
+			}
+			if seen[next] {
+				yield(zero, fmt.Errorf("pagination: cursor %q repeated", next))
+				return
+			}
+			seen[next] = true
+			cursor = &next
+		}
+	}
+}
+
+// IterExecutionList : every execution matching param, following the cursor
+func IterExecutionList(ctx context.Context, s V5ExecutionServiceI, param V5GetExecutionParam, opts ...PageOption) iter.Seq2[V5GetExecutionListItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetExecutionListItem, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetExecutionListWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterOpenOrders : every open order matching param, following the cursor
+func IterOpenOrders(ctx context.Context, s V5OrderServiceI, param V5GetOpenOrdersParam, opts ...PageOption) iter.Seq2[V5GetOrder, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetOrder, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetOpenOrdersWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterHistoryOrders : every historical order matching param, following the cursor
+func IterHistoryOrders(ctx context.Context, s V5OrderServiceI, param V5GetHistoryOrdersParam, opts ...PageOption) iter.Seq2[V5GetOrder, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetOrder, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetHistoryOrdersWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterPositionInfo : every position matching param, following the cursor
+func IterPositionInfo(ctx context.Context, s V5PositionServiceI, param V5GetPositionInfoParam, opts ...PageOption) iter.Seq2[V5GetPositionInfoItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetPositionInfoItem, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetPositionInfoWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterClosedPnL : every closed pnl record matching param, following the cursor
+func IterClosedPnL(ctx context.Context, s V5PositionServiceI, param V5GetClosedPnLParam, opts ...PageOption) iter.Seq2[V5GetClosedPnLItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetClosedPnLItem, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetClosedPnLWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterTransactionLog : every transaction log entry matching param, following the cursor
+func IterTransactionLog(ctx context.Context, s V5AccountServiceI, param V5GetTransactionLogParam, opts ...PageOption) iter.Seq2[V5GetTransactionLogItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetTransactionLogItem, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetTransactionLogWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterLinearInverseInstruments : every linear or inverse instrument matching param, following the cursor
+func IterLinearInverseInstruments(ctx context.Context, s V5MarketServiceI, param V5GetInstrumentsInfoParam, opts ...PageOption) iter.Seq2[V5GetInstrumentsInfoLinearInverseItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetInstrumentsInfoLinearInverseItem, string, error) {
+		if param.Category != CategoryV5Linear && param.Category != CategoryV5Inverse {
+			return nil, "", fmt.Errorf("category %s is not linear or inverse", param.Category)
+		}
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetInstrumentsInfoWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		if res.Result.LinearInverse == nil {
+			return nil, "", nil
+		}
+		return res.Result.LinearInverse.List, res.Result.LinearInverse.NextPageCursor, nil
+	}, opts)
+}
+
+// IterOptionInstruments : every option instrument matching param, following the cursor
+func IterOptionInstruments(ctx context.Context, s V5MarketServiceI, param V5GetInstrumentsInfoParam, opts ...PageOption) iter.Seq2[V5GetInstrumentsInfoOptionItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetInstrumentsInfoOptionItem, string, error) {
+		if param.Category != CategoryV5Option {
+			return nil, "", fmt.Errorf("category %s is not option", param.Category)
+		}
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetInstrumentsInfoWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		if res.Result.Option == nil {
+			return nil, "", nil
+		}
+		return res.Result.Option.List, res.Result.Option.NextPageCursor, nil
+	}, opts)
+}
+
+// IterSpotInstruments : every spot instrument matching param
+// Spot is not paginated by Bybit, so this is a single request.
+func IterSpotInstruments(ctx context.Context, s V5MarketServiceI, param V5GetInstrumentsInfoParam, opts ...PageOption) iter.Seq2[V5GetInstrumentsInfoSpotItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetInstrumentsInfoSpotItem, string, error) {
+		if param.Category != CategoryV5Spot {
+			return nil, "", fmt.Errorf("category %s is not spot", param.Category)
+		}
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetInstrumentsInfoWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		if res.Result.Spot == nil {
+			return nil, "", nil
+		}
+		return res.Result.Spot.List, "", nil
+	}, opts)
+}
+
+// IterOpenInterest : every open interest point matching param, following the cursor
+func IterOpenInterest(ctx context.Context, s V5MarketServiceI, param V5GetOpenInterestParam, opts ...PageOption) iter.Seq2[V5GetOpenInterestItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetOpenInterestItem, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetOpenInterestWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterRiskLimit : every risk limit tier matching param, following the cursor
+// The endpoint has no limit parameter, so WithPageSizeOption is ignored.
+func IterRiskLimit(ctx context.Context, s V5MarketServiceI, param V5GetRiskLimitParam, opts ...PageOption) iter.Seq2[V5GetRiskLimitItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, _ *int) ([]V5GetRiskLimitItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetRiskLimitWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterInternalTransferRecords : every internal transfer matching param, following the cursor
+func IterInternalTransferRecords(ctx context.Context, s V5AssetServiceI, param V5GetInternalTransferRecordsParam, opts ...PageOption) iter.Seq2[V5GetInternalTransferRecordsItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetInternalTransferRecordsItem, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetInternalTransferRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterUniversalTransferRecords : every universal transfer matching param, following the cursor
+func IterUniversalTransferRecords(ctx context.Context, s V5AssetServiceI, param V5GetUniversalTransferRecordsParam, opts ...PageOption) iter.Seq2[V5GetUniversalTransferRecordsItem, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetUniversalTransferRecordsItem, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetUniversalTransferRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterDepositRecords : every deposit matching param, following the cursor
+func IterDepositRecords(ctx context.Context, s V5AssetServiceI, param V5GetDepositRecordsParam, opts ...PageOption) iter.Seq2[V5GetDepositRecordsRow, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetDepositRecordsRow, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetDepositRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterSubDepositRecords : every sub account deposit matching param, following the cursor
+func IterSubDepositRecords(ctx context.Context, s V5AssetServiceI, param V5GetSubDepositRecordsParam, opts ...PageOption) iter.Seq2[V5GetSubDepositRecordsRow, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetSubDepositRecordsRow, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetSubDepositRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterInternalDepositRecords : every internal deposit matching param, following the cursor
+func IterInternalDepositRecords(ctx context.Context, s V5AssetServiceI, param V5GetInternalDepositRecordsParam, opts ...PageOption) iter.Seq2[V5GetInternalDepositRecordsRow, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetInternalDepositRecordsRow, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetInternalDepositRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterWithdrawalRecords : every withdrawal matching param, following the cursor
+func IterWithdrawalRecords(ctx context.Context, s V5AssetServiceI, param V5GetWithdrawalRecordsParam, opts ...PageOption) iter.Seq2[V5GetWithdrawalRecordsRow, error] {
+	return paginate(ctx, param.Cursor, func(ctx context.Context, cursor *string, limit *int) ([]V5GetWithdrawalRecordsRow, string, error) {
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetWithdrawalRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
@@ -0,0 +1,217 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withPagedHandlerOption serves items in pages of the requested limit, using the offset as cursor
+func withPagedHandlerOption(path string, items []string, calls *int32, limits *[]string) func(*http.ServeMux) {
+	return func(mux *http.ServeMux) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(calls, 1)
+			query := r.URL.Query()
+			*limits = append(*limits, query.Get("limit"))
+
+			limit := 2
+			if l, err := strconv.Atoi(query.Get("limit")); err == nil {
+				limit = l
+			}
+			offset, _ := strconv.Atoi(query.Get("cursor"))
+			end := min(offset+limit, len(items))
+			list := []map[string]string{}
+			for _, id := range items[offset:end] {
+				list = append(list, map[string]string{"execId": id, "orderId": id})
+			}
+			next := ""
+			if end < len(items) {
+				next = strconv.Itoa(end)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"retCode": 0,
+				"result": map[string]interface{}{
+					"category":       "linear",
+					"nextPageCursor": next,
+					"list":           list,
+				},
+			})
+		})
+	}
+}
+
+func TestIterExecutionList(t *testing.T) {
+	items := []string{"1", "2", "3", "4", "5"}
+
+	t.Run("follows the cursor", func(t *testing.T) {
+		var (
+			calls  int32
+			limits []string
+		)
+		server, teardown := testhelper.NewServer(withPagedHandlerOption("/v5/execution/list", items, &calls, &limits))
+		defer teardown()
+		client := NewTestClient().WithBaseURL(server.URL).WithAuth("test", "test")
+
+		var got []string
+		for item, err := range IterExecutionList(context.Background(), client.V5().Execution(), V5GetExecutionParam{Category: CategoryV5Linear}) {
+			require.NoError(t, err)
+			got = append(got, item.ExecID)
+		}
+		assert.Equal(t, items, got)
+		assert.Equal(t, int32(3), calls)
+		assert.Equal(t, []string{"", "", ""}, limits)
+	})
+	t.Run("resumes from the cursor of param", func(t *testing.T) {
+		var (
+			calls  int32
+			limits []string
+		)
+		server, teardown := testhelper.NewServer(withPagedHandlerOption("/v5/execution/list", items, &calls, &limits))
+		defer teardown()
+		client := NewTestClient().WithBaseURL(server.URL).WithAuth("test", "test")
+
+		var got []string
+		param := V5GetExecutionParam{Category: CategoryV5Linear, Cursor: testhelper.Ptr("2")}
+		for item, err := range IterExecutionList(context.Background(), client.V5().Execution(), param) {
+			require.NoError(t, err)
+			got = append(got, item.ExecID)
+		}
+		assert.Equal(t, items[2:], got)
+		assert.Equal(t, int32(2), calls)
+	})
+	t.Run("page size and max items", func(t *testing.T) {
+		var (
+			calls  int32
+			limits []string
+		)
+		server, teardown := testhelper.NewServer(withPagedHandlerOption("/v5/execution/list", items, &calls, &limits))
+		defer teardown()
+		client := NewTestClient().WithBaseURL(server.URL).WithAuth("test", "test")
+
+		var got []string
+		for item, err := range IterExecutionList(context.Background(), client.V5().Execution(), V5GetExecutionParam{Category: CategoryV5Linear}, WithPageSizeOption(3), WithMaxItemsOption(4)) {
+			require.NoError(t, err)
+			got = append(got, item.ExecID)
+		}
+		assert.Equal(t, []string{"1", "2", "3", "4"}, got)
+		assert.Equal(t, int32(2), calls)
+		assert.Equal(t, []string{"3", "3"}, limits)
+	})
+	t.Run("break stops fetching", func(t *testing.T) {
+		var (
+			calls  int32
+			limits []string
+		)
+		server, teardown := testhelper.NewServer(withPagedHandlerOption("/v5/execution/list", items, &calls, &limits))
+		defer teardown()
+		client := NewTestClient().WithBaseURL(server.URL).WithAuth("test", "test")
+
+		for _, err := range IterExecutionList(context.Background(), client.V5().Execution(), V5GetExecutionParam{Category: CategoryV5Linear}) {
+			require.NoError(t, err)
+			break
+		}
+		assert.Equal(t, int32(1), calls)
+	})
+	t.Run("canceled context", func(t *testing.T) {
+		var (
+			calls  int32
+			limits []string
+		)
+		server, teardown := testhelper.NewServer(withPagedHandlerOption("/v5/execution/list", items, &calls, &limits))
+		defer teardown()
+		client := NewTestClient().WithBaseURL(server.URL).WithAuth("test", "test")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var errs []error
+		for _, err := range IterExecutionList(ctx, client.V5().Execution(), V5GetExecutionParam{Category: CategoryV5Linear}) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			cancel()
+		}
+		require.Len(t, errs, 1)
+		assert.True(t, errors.Is(errs[0], context.Canceled))
+		assert.Equal(t, int32(1), calls)
+	})
+}
+
+func TestIterOpenOrders_Error(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/order/realtime", &calls,
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+				"retCode": 0,
+				"result": map[string]interface{}{
+					"category":       "linear",
+					"nextPageCursor": "page2",
+					"list":           []map[string]string{{"orderId": "1"}},
+				},
+			}),
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+				"retCode": 10001,
+				"retMsg":  "params error",
+			}),
+		),
+	)
+	defer teardown()
+	client := NewTestClient().WithBaseURL(server.URL).WithAuth("test", "test")
+
+	var (
+		got  []string
+		errs []error
+	)
+	for item, err := range IterOpenOrders(context.Background(), client.V5().Order(), V5GetOpenOrdersParam{Category: CategoryV5Linear}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, item.OrderID)
+	}
+	assert.Equal(t, []string{"1"}, got)
+	require.Len(t, errs, 1)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestIterRiskLimit_RepeatedCursor(t *testing.T) {
+	var calls int32
+	server, teardown := testhelper.NewServer(
+		withSequenceHandlerOption("/v5/market/risk-limit", &calls,
+			respondJSON(t, http.StatusOK, nil, map[string]interface{}{
+				"retCode": 0,
+				"result": map[string]interface{}{
+					"category":       "linear",
+					"nextPageCursor": "same",
+					"list":           []map[string]interface{}{{"id": 1}},
+				},
+			}),
+		),
+	)
+	defer teardown()
+	client := NewTestClient().WithBaseURL(server.URL)
+
+	var (
+		ids  []int64
+		errs []error
+	)
+	for item, err := range IterRiskLimit(context.Background(), client.V5().Market(), V5GetRiskLimitParam{Category: CategoryV5Linear}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, item.ID)
+	}
+	assert.Equal(t, []int64{1, 1}, ids)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "repeated")
+}
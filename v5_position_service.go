@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -10,13 +11,21 @@ import (
 // V5PositionServiceI :
 type V5PositionServiceI interface {
 	GetPositionInfo(V5GetPositionInfoParam) (*V5GetPositionInfoResponse, error)
+	GetPositionInfoWithContext(context.Context, V5GetPositionInfoParam) (*V5GetPositionInfoResponse, error)
 	SetLeverage(V5SetLeverageParam) (*V5SetLeverageResponse, error)
+	SetLeverageWithContext(context.Context, V5SetLeverageParam) (*V5SetLeverageResponse, error)
 	SetTradingStop(V5SetTradingStopParam) (*V5SetTradingStopResponse, error)
+	SetTradingStopWithContext(context.Context, V5SetTradingStopParam) (*V5SetTradingStopResponse, error)
 	SetTpSlMode(V5SetTpSlModeParam) (*V5SetTpSlModeResponse, error)
+	SetTpSlModeWithContext(context.Context, V5SetTpSlModeParam) (*V5SetTpSlModeResponse, error)
 	SwitchPositionMode(V5SwitchPositionModeParam) (*V5SwitchPositionModeResponse, error)
+	SwitchPositionModeWithContext(context.Context, V5SwitchPositionModeParam) (*V5SwitchPositionModeResponse, error)
 	GetClosedPnL(V5GetClosedPnLParam) (*V5GetClosedPnLResponse, error)
+	GetClosedPnLWithContext(context.Context, V5GetClosedPnLParam) (*V5GetClosedPnLResponse, error)
 	SwitchPositionMarginMode(V5SwitchPositionMarginModeParam) (*V5SwitchPositionMarginModeResponse, error)
+	SwitchPositionMarginModeWithContext(context.Context, V5SwitchPositionMarginModeParam) (*V5SwitchPositionMarginModeResponse, error)
 	SetRiskLimit(V5SetRiskLimitParam) (*V5SetRiskLimitResponse, error)
+	SetRiskLimitWithContext(context.Context, V5SetRiskLimitParam) (*V5SetRiskLimitResponse, error)
 }
 
 // V5PositionService :
@@ -89,6 +98,11 @@ type V5GetPositionInfoItem struct {
 
 // GetPositionInfo :
 func (s *V5PositionService) GetPositionInfo(param V5GetPositionInfoParam) (*V5GetPositionInfoResponse, error) {
+	return s.GetPositionInfoWithContext(context.Background(), param)
+}
+
+// GetPositionInfoWithContext :
+func (s *V5PositionService) GetPositionInfoWithContext(ctx context.Context, param V5GetPositionInfoParam) (*V5GetPositionInfoResponse, error) {
 	var res V5GetPositionInfoResponse
 
 	queryString, err := query.Values(param)
@@ -96,7 +110,7 @@ func (s *V5PositionService) GetPositionInfo(param V5GetPositionInfoParam) (*V5Ge
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/position/list", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/position/list", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -119,6 +133,11 @@ type V5SetLeverageResponse struct {
 
 // SetLeverage :
 func (s *V5PositionService) SetLeverage(param V5SetLeverageParam) (*V5SetLeverageResponse, error) {
+	return s.SetLeverageWithContext(context.Background(), param)
+}
+
+// SetLeverageWithContext :
+func (s *V5PositionService) SetLeverageWithContext(ctx context.Context, param V5SetLeverageParam) (*V5SetLeverageResponse, error) {
 	var res V5SetLeverageResponse
 
 	if param.Category == "" || param.Symbol == "" || param.BuyLeverage == "" || param.SellLeverage == "" {
@@ -130,7 +149,7 @@ func (s *V5PositionService) SetLeverage(param V5SetLeverageParam) (*V5SetLeverag
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/position/set-leverage", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/position/set-leverage", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -176,6 +195,11 @@ type V5SetTradingStopResponse struct {
 
 // SetTradingStop :
 func (s *V5PositionService) SetTradingStop(param V5SetTradingStopParam) (*V5SetTradingStopResponse, error) {
+	return s.SetTradingStopWithContext(context.Background(), param)
+}
+
+// SetTradingStopWithContext :
+func (s *V5PositionService) SetTradingStopWithContext(ctx context.Context, param V5SetTradingStopParam) (*V5SetTradingStopResponse, error) {
 	var res V5SetTradingStopResponse
 
 	if err := param.validate(); err != nil {
@@ -187,7 +211,7 @@ func (s *V5PositionService) SetTradingStop(param V5SetTradingStopParam) (*V5SetT
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/position/trading-stop", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/position/trading-stop", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -221,6 +245,11 @@ type V5SetTpSlModeResult struct {
 
 // SetTpSlMode :
 func (s *V5PositionService) SetTpSlMode(param V5SetTpSlModeParam) (*V5SetTpSlModeResponse, error) {
+	return s.SetTpSlModeWithContext(context.Background(), param)
+}
+
+// SetTpSlModeWithContext :
+func (s *V5PositionService) SetTpSlModeWithContext(ctx context.Context, param V5SetTpSlModeParam) (*V5SetTpSlModeResponse, error) {
 	var res V5SetTpSlModeResponse
 
 	if err := param.validate(); err != nil {
@@ -232,7 +261,7 @@ func (s *V5PositionService) SetTpSlMode(param V5SetTpSlModeParam) (*V5SetTpSlMod
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/position/set-tpsl-mode", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/position/set-tpsl-mode", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -263,6 +292,11 @@ type V5SwitchPositionModeResponse struct {
 
 // SwitchPositionMode :
 func (s *V5PositionService) SwitchPositionMode(param V5SwitchPositionModeParam) (*V5SwitchPositionModeResponse, error) {
+	return s.SwitchPositionModeWithContext(context.Background(), param)
+}
+
+// SwitchPositionModeWithContext :
+func (s *V5PositionService) SwitchPositionModeWithContext(ctx context.Context, param V5SwitchPositionModeParam) (*V5SwitchPositionModeResponse, error) {
 	var res V5SwitchPositionModeResponse
 
 	if err := param.validate(); err != nil {
@@ -274,7 +308,7 @@ func (s *V5PositionService) SwitchPositionMode(param V5SwitchPositionModeParam)
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/position/switch-mode", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/position/switch-mode", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -331,6 +365,11 @@ type V5GetClosedPnLItem struct {
 
 // GetClosedPnL :
 func (s *V5PositionService) GetClosedPnL(param V5GetClosedPnLParam) (*V5GetClosedPnLResponse, error) {
+	return s.GetClosedPnLWithContext(context.Background(), param)
+}
+
+// GetClosedPnLWithContext :
+func (s *V5PositionService) GetClosedPnLWithContext(ctx context.Context, param V5GetClosedPnLParam) (*V5GetClosedPnLResponse, error) {
 	var res V5GetClosedPnLResponse
 
 	queryString, err := query.Values(param)
@@ -338,7 +377,7 @@ func (s *V5PositionService) GetClosedPnL(param V5GetClosedPnLParam) (*V5GetClose
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/position/closed-pnl", queryString, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/position/closed-pnl", param, queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -372,6 +411,11 @@ type V5SwitchPositionMarginModeResponse struct {
 
 // SwitchPositionMarginMode :
 func (s *V5PositionService) SwitchPositionMarginMode(param V5SwitchPositionMarginModeParam) (*V5SwitchPositionMarginModeResponse, error) {
+	return s.SwitchPositionMarginModeWithContext(context.Background(), param)
+}
+
+// SwitchPositionMarginModeWithContext :
+func (s *V5PositionService) SwitchPositionMarginModeWithContext(ctx context.Context, param V5SwitchPositionMarginModeParam) (*V5SwitchPositionMarginModeResponse, error) {
 	var res V5SwitchPositionMarginModeResponse
 
 	if err := param.validate(); err != nil {
@@ -383,7 +427,7 @@ func (s *V5PositionService) SwitchPositionMarginMode(param V5SwitchPositionMargi
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/position/switch-isolated", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/position/switch-isolated", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -414,6 +458,11 @@ type V5SetRiskLimitResult struct {
 
 // SetRiskLimit :
 func (s *V5PositionService) SetRiskLimit(param V5SetRiskLimitParam) (*V5SetRiskLimitResponse, error) {
+	return s.SetRiskLimitWithContext(context.Background(), param)
+}
+
+// SetRiskLimitWithContext :
+func (s *V5PositionService) SetRiskLimitWithContext(ctx context.Context, param V5SetRiskLimitParam) (*V5SetRiskLimitResponse, error) {
 	var res V5SetRiskLimitResponse
 
 	body, err := json.Marshal(param)
@@ -421,7 +470,7 @@ func (s *V5PositionService) SetRiskLimit(param V5SetRiskLimitParam) (*V5SetRiskL
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/position/set-risk-limit", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/position/set-risk-limit", param, body, &res); err != nil {
 		return &res, err
 	}
 
@@ -0,0 +1,281 @@
+package bybit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+const (
+	// HistoryWindow7Days : the longest startTime/endTime range of the trade and transfer history endpoints
+	HistoryWindow7Days = 7 * 24 * time.Hour
+	// HistoryWindow30Days : the longest startTime/endTime range of the deposit and withdrawal records
+	HistoryWindow30Days = 30 * 24 * time.Hour
+)
+
+// TimeWindow : a range of time, both ends inclusive as Bybit treats startTime and endTime
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// SplitTimeRange : splits [start, end] into consecutive windows no longer than maxWindow, oldest first
+// Adjacent windows share their boundary, so records stamped exactly on it are returned by both.
+func SplitTimeRange(start, end time.Time, maxWindow time.Duration) []TimeWindow {
+	if maxWindow <= 0 || end.Before(start) {
+		return nil
+	}
+	var windows []TimeWindow
+	for from := start; ; from = from.Add(maxWindow) {
+		to := from.Add(maxWindow)
+		if !to.Before(end) {
+			return append(windows, TimeWindow{Start: from, End: end})
+		}
+		windows = append(windows, TimeWindow{Start: from, End: to})
+	}
+}
+
+// WithTimeWindowOption : overrides the window length of the ...InRange iterators
+func WithTimeWindowOption(d time.Duration) PageOption {
+	return func(c *pageConfig) {
+		c.window = d
+	}
+}
+
+// windowFetcher requests one page of the window
+type windowFetcher[T any] func(ctx context.Context, window TimeWindow, cursor *string, limit *int) ([]T, string, error)
+
+// paginateRange walks the windows of [start, end], following the cursor inside each window
+// Items already yielded by the previous window are skipped, which only needs the keys of one window.
+func paginateRange[T any](ctx context.Context, start, end time.Time, window time.Duration, key func(T) string, fetch windowFetcher[T], opts []PageOption) iter.Seq2[T, error] {
+	config := pageConfig{window: window}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		if end.Before(start) {
+			yield(zero, fmt.Errorf("time range: end %v is before start %v", end, start))
+			return
+		}
+		if config.window <= 0 {
+			yield(zero, fmt.Errorf("time range: window must be positive, got %v", config.window))
+			return
+		}
+
+		var (
+			count    int
+			previous = map[string]bool{}
+		)
+		for _, w := range SplitTimeRange(start, end, config.window) {
+			current := map[string]bool{}
+			pages := paginate(ctx, nil, func(ctx context.Context, cursor *string, limit *int) ([]T, string, error) {
+				return fetch(ctx, w, cursor, limit)
+			}, []PageOption{WithPageSizeOption(config.pageSize)})
+			for item, err := range pages {
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				k := key(item)
+				if previous[k] || current[k] {
+					continue
+				}
+				current[k] = true
+				if !yield(item, nil) {
+					return
+				}
+				count++
+				if config.maxItems > 0 && count >= config.maxItems {
+					return
+				}
+			}
+			previous = current
+		}
+	}
+}
+
+func windowMilli(w TimeWindow) (*int64, *int64) {
+	start, end := w.Start.UnixMilli(), w.End.UnixMilli()
+	return &start, &end
+}
+
+func windowMilliInt(w TimeWindow) (*int, *int) {
+	start, end := int(w.Start.UnixMilli()), int(w.End.UnixMilli())
+	return &start, &end
+}
+
+// IterExecutionListInRange : every execution between start and end, in 7 day windows
+func IterExecutionListInRange(ctx context.Context, s V5ExecutionServiceI, param V5GetExecutionParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetExecutionListItem, error] {
+	key := func(item V5GetExecutionListItem) string { return item.ExecID }
+	return paginateRange(ctx, start, end, HistoryWindow7Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetExecutionListItem, string, error) {
+		param.StartTime, param.EndTime = windowMilliInt(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetExecutionListWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterHistoryOrdersInRange : every historical order between start and end, in 7 day windows
+func IterHistoryOrdersInRange(ctx context.Context, s V5OrderServiceI, param V5GetHistoryOrdersParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetOrder, error] {
+	key := func(item V5GetOrder) string { return item.OrderID }
+	return paginateRange(ctx, start, end, HistoryWindow7Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetOrder, string, error) {
+		param.StartTime, param.EndTime = windowMilliInt(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetHistoryOrdersWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterClosedPnLInRange : every closed pnl record between start and end, in 7 day windows
+func IterClosedPnLInRange(ctx context.Context, s V5PositionServiceI, param V5GetClosedPnLParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetClosedPnLItem, error] {
+	key := func(item V5GetClosedPnLItem) string { return item.OrderID + "/" + item.CreatedTime }
+	return paginateRange(ctx, start, end, HistoryWindow7Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetClosedPnLItem, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetClosedPnLWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterTransactionLogInRange : every transaction log entry between start and end, in 7 day windows
+// Entries have no id, so they are told apart by time, type, trade, order, currency and change.
+func IterTransactionLogInRange(ctx context.Context, s V5AccountServiceI, param V5GetTransactionLogParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetTransactionLogItem, error] {
+	key := func(item V5GetTransactionLogItem) string {
+		return fmt.Sprintf("%s/%s/%s/%s/%s/%s", item.TransactionTime, item.Type, item.TradeID, item.OrderID, item.Currency, item.Change)
+	}
+	return paginateRange(ctx, start, end, HistoryWindow7Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetTransactionLogItem, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetTransactionLogWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterInternalTransferRecordsInRange : every internal transfer between start and end, in 7 day windows
+func IterInternalTransferRecordsInRange(ctx context.Context, s V5AssetServiceI, param V5GetInternalTransferRecordsParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetInternalTransferRecordsItem, error] {
+	key := func(item V5GetInternalTransferRecordsItem) string { return item.TransferID }
+	return paginateRange(ctx, start, end, HistoryWindow7Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetInternalTransferRecordsItem, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetInternalTransferRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterUniversalTransferRecordsInRange : every universal transfer between start and end, in 7 day windows
+func IterUniversalTransferRecordsInRange(ctx context.Context, s V5AssetServiceI, param V5GetUniversalTransferRecordsParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetUniversalTransferRecordsItem, error] {
+	key := func(item V5GetUniversalTransferRecordsItem) string { return item.TransferID }
+	return paginateRange(ctx, start, end, HistoryWindow7Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetUniversalTransferRecordsItem, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetUniversalTransferRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterDepositRecordsInRange : every deposit between start and end, in 30 day windows
+func IterDepositRecordsInRange(ctx context.Context, s V5AssetServiceI, param V5GetDepositRecordsParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetDepositRecordsRow, error] {
+	key := func(item V5GetDepositRecordsRow) string { return item.TxID + "/" + item.TxIndex }
+	return paginateRange(ctx, start, end, HistoryWindow30Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetDepositRecordsRow, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetDepositRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterSubDepositRecordsInRange : every sub account deposit between start and end, in 30 day windows
+func IterSubDepositRecordsInRange(ctx context.Context, s V5AssetServiceI, param V5GetSubDepositRecordsParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetSubDepositRecordsRow, error] {
+	key := func(item V5GetSubDepositRecordsRow) string { return item.TxID + "/" + item.TxIndex }
+	return paginateRange(ctx, start, end, HistoryWindow30Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetSubDepositRecordsRow, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetSubDepositRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterInternalDepositRecordsInRange : every internal deposit between start and end, in 30 day windows
+func IterInternalDepositRecordsInRange(ctx context.Context, s V5AssetServiceI, param V5GetInternalDepositRecordsParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetInternalDepositRecordsRow, error] {
+	key := func(item V5GetInternalDepositRecordsRow) string { return item.ID }
+	return paginateRange(ctx, start, end, HistoryWindow30Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetInternalDepositRecordsRow, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetInternalDepositRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
+
+// IterWithdrawalRecordsInRange : every withdrawal between start and end, in 30 day windows
+func IterWithdrawalRecordsInRange(ctx context.Context, s V5AssetServiceI, param V5GetWithdrawalRecordsParam, start, end time.Time, opts ...PageOption) iter.Seq2[V5GetWithdrawalRecordsRow, error] {
+	key := func(item V5GetWithdrawalRecordsRow) string { return item.WithdrawID }
+	return paginateRange(ctx, start, end, HistoryWindow30Days, key, func(ctx context.Context, w TimeWindow, cursor *string, limit *int) ([]V5GetWithdrawalRecordsRow, string, error) {
+		param.StartTime, param.EndTime = windowMilli(w)
+		param.Cursor = cursor
+		if limit != nil {
+			param.Limit = limit
+		}
+		res, err := s.GetWithdrawalRecordsWithContext(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	}, opts)
+}
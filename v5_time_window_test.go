@@ -0,0 +1,130 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hirokisan/bybit/v2/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitTimeRange(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	t.Run("exact multiple", func(t *testing.T) {
+		windows := SplitTimeRange(start, start.Add(14*day), HistoryWindow7Days)
+		assert.Equal(t, []TimeWindow{
+			{Start: start, End: start.Add(7 * day)},
+			{Start: start.Add(7 * day), End: start.Add(14 * day)},
+		}, windows)
+	})
+	t.Run("remainder", func(t *testing.T) {
+		windows := SplitTimeRange(start, start.Add(31*day), HistoryWindow7Days)
+		require.Len(t, windows, 5)
+		assert.Equal(t, TimeWindow{Start: start.Add(28 * day), End: start.Add(31 * day)}, windows[4])
+	})
+	t.Run("shorter than a window", func(t *testing.T) {
+		assert.Equal(t, []TimeWindow{{Start: start, End: start.Add(time.Hour)}}, SplitTimeRange(start, start.Add(time.Hour), HistoryWindow7Days))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		assert.Nil(t, SplitTimeRange(start, start.Add(-time.Hour), HistoryWindow7Days))
+		assert.Nil(t, SplitTimeRange(start, start.Add(time.Hour), 0))
+	})
+}
+
+func TestIterExecutionListInRange(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	// one execution per day, the one on day 7 sits on the boundary of the first two windows
+	var executions []time.Time
+	for i := 0; i <= 20; i++ {
+		executions = append(executions, start.Add(time.Duration(i)*day))
+	}
+
+	var (
+		mu     sync.Mutex
+		ranges [][2]int64
+	)
+	server, teardown := testhelper.NewServer(func(mux *http.ServeMux) {
+		mux.HandleFunc("/v5/execution/list", func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			from, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+			to, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+			mu.Lock()
+			if query.Get("cursor") == "" {
+				ranges = append(ranges, [2]int64{from, to})
+			}
+			mu.Unlock()
+
+			var inWindow []map[string]string
+			for _, at := range executions {
+				if ms := at.UnixMilli(); ms >= from && ms <= to {
+					inWindow = append(inWindow, map[string]string{"execId": at.Format("2006-01-02"), "execTime": strconv.FormatInt(ms, 10)})
+				}
+			}
+			// pages of two, the offset is the cursor
+			offset, _ := strconv.Atoi(query.Get("cursor"))
+			end := min(offset+2, len(inWindow))
+			next := ""
+			if end < len(inWindow) {
+				next = strconv.Itoa(end)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"retCode": 0,
+				"result": map[string]interface{}{
+					"category":       "linear",
+					"nextPageCursor": next,
+					"list":           inWindow[offset:end],
+				},
+			})
+		})
+	})
+	defer teardown()
+	client := NewTestClient().WithBaseURL(server.URL).WithAuth("test", "test")
+
+	t.Run("windows and dedupe", func(t *testing.T) {
+		ranges = nil
+		var got []string
+		for item, err := range IterExecutionListInRange(context.Background(), client.V5().Execution(), V5GetExecutionParam{Category: CategoryV5Linear}, start, start.Add(20*day)) {
+			require.NoError(t, err)
+			got = append(got, item.ExecID)
+		}
+		require.Len(t, got, 21)
+		seen := map[string]bool{}
+		for _, id := range got {
+			assert.False(t, seen[id], id)
+			seen[id] = true
+		}
+		require.Len(t, ranges, 3)
+		for _, r := range ranges {
+			assert.LessOrEqual(t, r[1]-r[0], HistoryWindow7Days.Milliseconds())
+		}
+	})
+	t.Run("window and max items options", func(t *testing.T) {
+		ranges = nil
+		var got []string
+		for item, err := range IterExecutionListInRange(context.Background(), client.V5().Execution(), V5GetExecutionParam{Category: CategoryV5Linear}, start, start.Add(20*day),
+			WithTimeWindowOption(2*day), WithMaxItemsOption(4)) {
+			require.NoError(t, err)
+			got = append(got, item.ExecID)
+		}
+		assert.Equal(t, []string{"2024-01-01", "2024-01-02", "2024-01-03", "2024-01-04"}, got)
+		assert.Len(t, ranges, 2)
+	})
+	t.Run("end before start", func(t *testing.T) {
+		var errs []error
+		for _, err := range IterExecutionListInRange(context.Background(), client.V5().Execution(), V5GetExecutionParam{Category: CategoryV5Linear}, start, start.Add(-day)) {
+			errs = append(errs, err)
+		}
+		require.Len(t, errs, 1)
+		assert.Error(t, errs[0])
+	})
+}
@@ -1,6 +1,7 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"time"
@@ -9,9 +10,13 @@ import (
 // V5UserServiceI :
 type V5UserServiceI interface {
 	CreateSubUID(param V5CreateSubUIDParam) (*V5CreateSubUIDResponse, error)
+	CreateSubUIDWithContext(ctx context.Context, param V5CreateSubUIDParam) (*V5CreateSubUIDResponse, error)
 	GetSubUIDList() (*V5GetSubUIDListResponse, error)
+	GetSubUIDListWithContext(context.Context) (*V5GetSubUIDListResponse, error)
 	CreateSubUIDAPIKey(param V5CreateSubUIDAPIKeyParam) (*V5CreateSubUIDAPIKeyResponse, error)
+	CreateSubUIDAPIKeyWithContext(ctx context.Context, param V5CreateSubUIDAPIKeyParam) (*V5CreateSubUIDAPIKeyResponse, error)
 	GetAPIKey() (*V5APIKeyResponse, error)
+	GetAPIKeyWithContext(context.Context) (*V5APIKeyResponse, error)
 }
 
 // V5UserService :
@@ -155,6 +160,11 @@ type V5ApiKeyResult struct {
 
 // CreateSubUID :
 func (s *V5UserService) CreateSubUID(param V5CreateSubUIDParam) (*V5CreateSubUIDResponse, error) {
+	return s.CreateSubUIDWithContext(context.Background(), param)
+}
+
+// CreateSubUIDWithContext :
+func (s *V5UserService) CreateSubUIDWithContext(ctx context.Context, param V5CreateSubUIDParam) (*V5CreateSubUIDResponse, error) {
 	var (
 		res V5CreateSubUIDResponse
 	)
@@ -164,7 +174,7 @@ func (s *V5UserService) CreateSubUID(param V5CreateSubUIDParam) (*V5CreateSubUID
 		return nil, err
 	}
 
-	if err := s.client.postV5JSON("/v5/user/create-sub-member", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/user/create-sub-member", param, body, &res); err != nil {
 		return nil, err
 	}
 
@@ -173,11 +183,16 @@ func (s *V5UserService) CreateSubUID(param V5CreateSubUIDParam) (*V5CreateSubUID
 
 // GetSubUIDList :
 func (s *V5UserService) GetSubUIDList() (*V5GetSubUIDListResponse, error) {
+	return s.GetSubUIDListWithContext(context.Background())
+}
+
+// GetSubUIDListWithContext :
+func (s *V5UserService) GetSubUIDListWithContext(ctx context.Context) (*V5GetSubUIDListResponse, error) {
 	var (
 		res V5GetSubUIDListResponse
 	)
 
-	if err := s.client.getV5Privately("/v5/user/query-sub-members", url.Values{}, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/user/query-sub-members", nil, url.Values{}, &res); err != nil {
 		return nil, err
 	}
 
@@ -186,6 +201,11 @@ func (s *V5UserService) GetSubUIDList() (*V5GetSubUIDListResponse, error) {
 
 // CreateSubUIDAPIKey :
 func (s *V5UserService) CreateSubUIDAPIKey(param V5CreateSubUIDAPIKeyParam) (*V5CreateSubUIDAPIKeyResponse, error) {
+	return s.CreateSubUIDAPIKeyWithContext(context.Background(), param)
+}
+
+// CreateSubUIDAPIKeyWithContext :
+func (s *V5UserService) CreateSubUIDAPIKeyWithContext(ctx context.Context, param V5CreateSubUIDAPIKeyParam) (*V5CreateSubUIDAPIKeyResponse, error) {
 	var (
 		res V5CreateSubUIDAPIKeyResponse
 	)
@@ -195,7 +215,7 @@ func (s *V5UserService) CreateSubUIDAPIKey(param V5CreateSubUIDAPIKeyParam) (*V5
 		return nil, err
 	}
 
-	if err := s.client.postV5JSON("/v5/user/create-sub-api", body, &res); err != nil {
+	if err := s.client.postV5JSON(ctx, "/v5/user/create-sub-api", param, body, &res); err != nil {
 		return nil, err
 	}
 
@@ -204,11 +224,16 @@ func (s *V5UserService) CreateSubUIDAPIKey(param V5CreateSubUIDAPIKeyParam) (*V5
 
 // GetAPIKey :
 func (s *V5UserService) GetAPIKey() (*V5APIKeyResponse, error) {
+	return s.GetAPIKeyWithContext(context.Background())
+}
+
+// GetAPIKeyWithContext :
+func (s *V5UserService) GetAPIKeyWithContext(ctx context.Context) (*V5APIKeyResponse, error) {
 	var (
 		res V5APIKeyResponse
 	)
 
-	if err := s.client.getV5Privately("/v5/user/query-api", url.Values{}, &res); err != nil {
+	if err := s.client.getV5Privately(ctx, "/v5/user/query-api", nil, url.Values{}, &res); err != nil {
 		return nil, err
 	}
 
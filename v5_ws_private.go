@@ -140,6 +140,7 @@ func (s *V5WebsocketPrivateService) Start(ctx context.Context, errHandler ErrHan
 
 		for {
 			if err := s.Run(); err != nil {
+				s.client.observeDisconnect(WebsocketServicePrivate, "", err)
 				if errHandler == nil {
 					return
 				}
@@ -164,7 +165,7 @@ func (s *V5WebsocketPrivateService) Start(ctx context.Context, errHandler ErrHan
 				return err
 			}
 		case <-ctx.Done():
-			s.client.debugf("caught websocket private service interrupt signal")
+			s.client.log().Info("caught websocket private service interrupt signal")
 
 			if err := s.Close(); err != nil {
 				return err
@@ -184,6 +185,7 @@ func (s *V5WebsocketPrivateService) Run() error {
 	if err != nil {
 		return err
 	}
+	s.client.observeMessage(WebsocketServicePrivate, "", message)
 
 	topic, err := s.judgeTopic(message)
 	if err != nil {
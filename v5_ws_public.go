@@ -175,6 +175,7 @@ func (s *V5WebsocketPublicService) Start(ctx context.Context, errHandler ErrHand
 
 		for {
 			if err := s.Run(); err != nil {
+				s.client.observeDisconnect(WebsocketServicePublic, s.category, err)
 				if errHandler == nil {
 					return
 				}
@@ -199,7 +200,7 @@ func (s *V5WebsocketPublicService) Start(ctx context.Context, errHandler ErrHand
 				return err
 			}
 		case <-ctx.Done():
-			s.client.debugf("caught websocket public service interrupt signal")
+			s.client.log().Info("caught websocket public service interrupt signal")
 
 			if err := s.Close(); err != nil {
 				return err
@@ -219,6 +220,7 @@ func (s *V5WebsocketPublicService) Run() error {
 	if err != nil {
 		return err
 	}
+	s.client.observeMessage(WebsocketServicePublic, s.category, message)
 
 	topic, err := s.judgeTopic(message)
 	if err != nil {
@@ -92,6 +92,7 @@ func (s *V5WebsocketTradeService) Start(ctx context.Context, errHandler ErrHandl
 
 		for {
 			if err := s.Run(); err != nil {
+				s.client.observeDisconnect(WebsocketServiceTrade, "", err)
 				if errHandler == nil {
 					return
 				}
@@ -116,7 +117,7 @@ func (s *V5WebsocketTradeService) Start(ctx context.Context, errHandler ErrHandl
 				return err
 			}
 		case <-ctx.Done():
-			s.client.debugf("caught websocket trade service interrupt signal")
+			s.client.log().Info("caught websocket trade service interrupt signal")
 
 			if err := s.Close(); err != nil {
 				return err
@@ -136,6 +137,7 @@ func (s *V5WebsocketTradeService) Run() error {
 	if err != nil {
 		return err
 	}
+	s.client.observeMessage(WebsocketServiceTrade, "", message)
 
 	topic, err := s.judgeTopic(message)
 	if err != nil {
@@ -14,7 +14,7 @@ func (s *V5WebsocketTradeService) CreateOrder(orders []*V5CreateOrderParam) erro
 	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
 	headers := make(map[string]string)
 	headers["X-BAPI-TIMESTAMP"] = timestamp
-	headers["X-BAPI-RECV-WINDOW"] = "8000"
+	headers["X-BAPI-RECV-WINDOW"] = s.client.tradeRecvWindow()
 
 	param := struct {
 		ReqId   string                `json:"reqId"`
@@ -42,7 +42,7 @@ func (s *V5WebsocketTradeService) CancelOrder(orders []*V5CancelOrderParam) erro
 	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
 	headers := make(map[string]string)
 	headers["X-BAPI-TIMESTAMP"] = timestamp
-	headers["X-BAPI-RECV-WINDOW"] = "8000"
+	headers["X-BAPI-RECV-WINDOW"] = s.client.tradeRecvWindow()
 
 	param := struct {
 		ReqId   string                `json:"reqId"`
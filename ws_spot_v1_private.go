@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"log/slog"
 	"os"
 	"os/signal"
 	"time"
@@ -189,7 +190,7 @@ func (s *SpotWebsocketV1PrivateService) Start(ctx context.Context) {
 				if IsErrWebsocketClosed(err) {
 					return
 				}
-				s.client.debugf("spot websocket v1 private service run error: %s", err)
+				s.client.log().Error("spot websocket v1 private service run error", slog.Any("error", err))
 				return
 			}
 		}
@@ -210,7 +211,7 @@ func (s *SpotWebsocketV1PrivateService) Start(ctx context.Context) {
 				return
 			}
 		case <-ctx.Done():
-			s.client.debugf("caught spot websocket v1 private service interrupt signal")
+			s.client.log().Info("caught spot websocket v1 private service interrupt signal")
 
 			if err := s.Close(); err != nil {
 				return